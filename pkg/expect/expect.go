@@ -35,15 +35,27 @@ type ExpectProcess struct {
 	wg   sync.WaitGroup
 
 	cond  *sync.Cond // for broadcasting updates are available
-	mu    sync.Mutex // protects lines and err
+	mu    sync.Mutex // protects lines, allLines and err
 	lines []string
 	count int // increment whenever new line gets added
 	err   error
 
+	retainLines bool     // whether allLines is populated
+	allLines    []string // every line read, including consumed ones
+
 	// StopSignal is the signal Stop sends to the process; defaults to SIGKILL.
 	StopSignal os.Signal
 }
 
+// ExpectOption configures an ExpectProcess before it is started.
+type ExpectOption func(*ExpectProcess)
+
+// WithRetainedLines makes the process keep every line it reads,
+// so that Lines can return the full output.
+func WithRetainedLines() ExpectOption {
+	return func(ep *ExpectProcess) { ep.retainLines = true }
+}
+
 // NewExpect creates a new process for expect testing.
 func NewExpect(name string, arg ...string) (ep *ExpectProcess, err error) {
 	// if env[] is nil, use current system env
@@ -51,13 +63,16 @@ func NewExpect(name string, arg ...string) (ep *ExpectProcess, err error) {
 }
 
 // NewExpectWithEnv creates a new process with user defined env variables for expect testing.
-func NewExpectWithEnv(name string, args []string, env []string) (ep *ExpectProcess, err error) {
+func NewExpectWithEnv(name string, args []string, env []string, opts ...ExpectOption) (ep *ExpectProcess, err error) {
 	cmd := exec.Command(name, args...)
 	cmd.Env = env
 	ep = &ExpectProcess{
 		cmd:        cmd,
 		StopSignal: syscall.SIGKILL,
 	}
+	for _, opt := range opts {
+		opt(ep)
+	}
 	ep.cond = sync.NewCond(&ep.mu)
 	ep.cmd.Stderr = ep.cmd.Stdout
 	ep.cmd.Stdin = nil
@@ -84,6 +99,9 @@ func (ep *ExpectProcess) read() {
 				fmt.Printf("%s-%d: %s", ep.cmd.Path, ep.cmd.Process.Pid, l)
 			}
 			ep.lines = append(ep.lines, l)
+			if ep.retainLines {
+				ep.allLines = append(ep.allLines, l)
+			}
 			ep.count++
 			if len(ep.lines) == 1 {
 				ep.cond.Signal()
@@ -128,6 +146,24 @@ func (ep *ExpectProcess) LineCount() int {
 	return ep.count
 }
 
+// Lines returns all lines recorded since the beginning of the process,
+// including the ones already consumed by Expect and ExpectFunc.
+// It returns nil unless the process was created with WithRetainedLines.
+func (ep *ExpectProcess) Lines() []string {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	return append([]string(nil), ep.allLines...)
+}
+
+// Pid returns the process ID of the expect process,
+// or 0 once the process has been closed.
+func (ep *ExpectProcess) Pid() int {
+	if ep.cmd == nil || ep.cmd.Process == nil {
+		return 0
+	}
+	return ep.cmd.Process.Pid
+}
+
 // Stop kills the expect process and waits for it to exit.
 func (ep *ExpectProcess) Stop() error { return ep.close(true) }
 
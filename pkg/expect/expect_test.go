@@ -18,6 +18,7 @@ package expect
 
 import (
 	"os"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -82,6 +83,39 @@ func TestLineCount(t *testing.T) {
 	}
 }
 
+func TestLines(t *testing.T) {
+	ep, err := NewExpectWithEnv("/usr/bin/printf", []string{"1\n2\n3\n"}, nil, WithRetainedLines())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, eerr := ep.Expect("2"); eerr != nil {
+		t.Fatal(eerr)
+	}
+	if cerr := ep.Close(); cerr != nil {
+		t.Fatal(cerr)
+	}
+	wlines := []string{"1\r\n", "2\r\n", "3\r\n"}
+	if lines := ep.Lines(); !reflect.DeepEqual(lines, wlines) {
+		t.Fatalf("got %q, expected %q", lines, wlines)
+	}
+}
+
+func TestLinesNotRetained(t *testing.T) {
+	ep, err := NewExpect("/usr/bin/printf", "1\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, eerr := ep.Expect("1"); eerr != nil {
+		t.Fatal(eerr)
+	}
+	if cerr := ep.Close(); cerr != nil {
+		t.Fatal(cerr)
+	}
+	if lines := ep.Lines(); lines != nil {
+		t.Fatalf("got %q, expected no retained lines", lines)
+	}
+}
+
 func TestSend(t *testing.T) {
 	ep, err := NewExpect("/usr/bin/tr", "a", "b")
 	if err != nil {
@@ -0,0 +1,76 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+
+	"go.etcd.io/etcd/pkg/v3/proxy"
+)
+
+// startClientProxies places a TCP proxy in front of the client URL of
+// every member. Clients dialing ClientProxyEndpoints can then have their
+// connections to a single member delayed, paused or dropped through
+// ClientProxy while peer traffic is unaffected.
+func (epc *etcdProcessCluster) startClientProxies() error {
+	for i, p := range epc.procs {
+		to, err := url.Parse(p.Config().acurl)
+		if err != nil {
+			return err
+		}
+		host, _, err := net.SplitHostPort(to.Host)
+		if err != nil {
+			return err
+		}
+		from := *to
+		from.Host = fmt.Sprintf("%s:%d", host, epc.cfg.clientProxyPort(i))
+
+		ps := proxy.NewServer(proxy.ServerConfig{From: from, To: *to})
+		epc.clientProxies = append(epc.clientProxies, ps)
+		epc.clientProxyEps = append(epc.clientProxyEps, from.String())
+		select {
+		case err = <-ps.Error():
+			return err
+		default:
+		}
+	}
+	return nil
+}
+
+// ClientProxyEndpoints returns the proxied client endpoints of all members.
+func (epc *etcdProcessCluster) ClientProxyEndpoints() []string {
+	return epc.clientProxyEps
+}
+
+// ClientProxy returns the proxy in front of the i-th member's client URL,
+// or nil if the cluster was started without cfg.clientProxy.
+func (epc *etcdProcessCluster) ClientProxy(i int) proxy.Server {
+	if i < 0 || i >= len(epc.clientProxies) {
+		return nil
+	}
+	return epc.clientProxies[i]
+}
+
+func (epc *etcdProcessCluster) closeClientProxies() (err error) {
+	for _, p := range epc.clientProxies {
+		if cerr := p.Close(); cerr != nil {
+			err = cerr
+		}
+	}
+	epc.clientProxies, epc.clientProxyEps = nil, nil
+	return err
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"fmt"
+	"strings"
+
+	"go.etcd.io/etcd/pkg/v3/expect"
+)
+
+var grpcProxyReadyLines = []string{"started gRPC proxy"}
+
+// grpcProxyConfig configures a grpc-proxy placed in front of all members
+// of an etcdProcessCluster. Watch coalescing and lease keepalive forwarding
+// are always enabled by grpc-proxy and have no settings of their own.
+type grpcProxyConfig struct {
+	// namespace prefixes every key accessed through the proxy.
+	namespace string
+	// leasingPrefix enables the experimental leasing KV using the given
+	// metadata prefix.
+	leasingPrefix string
+	// serializableOrdering ensures serializable reads have monotonically
+	// increasing revisions across endpoints.
+	serializableOrdering bool
+}
+
+type grpcProxyProcess struct {
+	execPath string
+	args     []string
+	envVars  map[string]string
+	ep       string
+
+	proc *expect.ExpectProcess
+}
+
+func newGRPCProxyProcess(cfg *etcdProcessClusterConfig, endpoints []string) (*grpcProxyProcess, error) {
+	if cfg.clientTLS != clientNonTLS {
+		return nil, fmt.Errorf("grpc-proxy only supports non-TLS client connections")
+	}
+	listenAddr := fmt.Sprintf("localhost:%d", cfg.grpcProxyPort())
+	args := []string{
+		"grpc-proxy",
+		"start",
+		"--listen-addr", listenAddr,
+		"--endpoints", strings.Join(endpoints, ","),
+		// pass-through member RPCs
+		"--advertise-client-url", "",
+	}
+	if cfg.grpcProxy.namespace != "" {
+		args = append(args, "--namespace", cfg.grpcProxy.namespace)
+	}
+	if cfg.grpcProxy.leasingPrefix != "" {
+		args = append(args, "--experimental-leasing-prefix", cfg.grpcProxy.leasingPrefix)
+	}
+	if cfg.grpcProxy.serializableOrdering {
+		args = append(args, "--experimental-serializable-ordering")
+	}
+	return &grpcProxyProcess{
+		execPath: cfg.execPath,
+		args:     args,
+		envVars:  cfg.envVars,
+		ep:       "http://" + listenAddr,
+	}, nil
+}
+
+func (gp *grpcProxyProcess) EndpointsV3() []string { return []string{gp.ep} }
+
+func (gp *grpcProxyProcess) Start() error {
+	if gp.proc != nil {
+		panic("already started")
+	}
+	proc, err := spawnCmdWithEnv(append([]string{gp.execPath}, gp.args...), gp.envVars)
+	if err != nil {
+		return err
+	}
+	gp.proc = proc
+	return waitReadyExpectProc(gp.proc, grpcProxyReadyLines)
+}
+
+func (gp *grpcProxyProcess) Restart() error {
+	if err := gp.Stop(); err != nil {
+		return err
+	}
+	return gp.Start()
+}
+
+func (gp *grpcProxyProcess) Stop() error {
+	if gp == nil || gp.proc == nil {
+		return nil
+	}
+	if err := gp.proc.Stop(); err != nil {
+		return err
+	}
+	gp.proc = nil
+	return nil
+}
+
+func (gp *grpcProxyProcess) Close() error { return gp.Stop() }
@@ -52,6 +52,10 @@ func newProxyEtcdProcess(cfg *etcdServerProcessConfig) (*proxyEtcdProcess, error
 
 func (p *proxyEtcdProcess) Config() *etcdServerProcessConfig { return p.etcdProc.Config() }
 
+func (p *proxyEtcdProcess) Logs() memberLogs { return p.etcdProc.Logs() }
+
+func (p *proxyEtcdProcess) ResourceUsage() []resourceUsage { return p.etcdProc.ResourceUsage() }
+
 func (p *proxyEtcdProcess) EndpointsV2() []string { return p.proxyV2.endpoints() }
 func (p *proxyEtcdProcess) EndpointsV3() []string { return p.proxyV3.endpoints() }
 func (p *proxyEtcdProcess) EndpointsMetrics() []string {
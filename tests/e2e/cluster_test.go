@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"go.etcd.io/etcd/pkg/v3/proxy"
 	"go.etcd.io/etcd/server/v3/etcdserver"
 )
 
@@ -129,6 +130,13 @@ func configStandalone(cfg etcdProcessClusterConfig) *etcdProcessClusterConfig {
 type etcdProcessCluster struct {
 	cfg   *etcdProcessClusterConfig
 	procs []etcdProcess
+
+	// grpcProxy is nil unless cfg.grpcProxy is set.
+	grpcProxy *grpcProxyProcess
+
+	// clientProxies are empty unless cfg.clientProxy is set.
+	clientProxies  []proxy.Server
+	clientProxyEps []string
 }
 
 type etcdProcessClusterConfig struct {
@@ -163,6 +171,23 @@ type etcdProcessClusterConfig struct {
 	initialCorruptCheck bool
 	authTokenOpts       string
 
+	// envVars are set for every member process and the grpc-proxy.
+	envVars map[string]string
+	// memberEnvVars, indexed by member, are merged over envVars
+	// for the corresponding member (e.g. GOGC=20 on a single member).
+	memberEnvVars []map[string]string
+
+	// grpcProxy, if set, starts a grpc-proxy in front of all members.
+	grpcProxy *grpcProxyConfig
+
+	// resourceMonitorInterval, if non-zero, is how often the CPU, memory,
+	// open file descriptors and data dir size of each member are sampled.
+	resourceMonitorInterval time.Duration
+
+	// clientProxy places a proxy in front of each member's client URL,
+	// see startClientProxies.
+	clientProxy bool
+
 	rollingStart bool
 }
 
@@ -187,6 +212,15 @@ func newEtcdProcessCluster(t testing.TB, cfg *etcdProcessClusterConfig) (*etcdPr
 		epc.procs[i] = proc
 	}
 
+	if cfg.grpcProxy != nil {
+		gp, err := newGRPCProxyProcess(cfg, epc.EndpointsV3())
+		if err != nil {
+			epc.Close()
+			return nil, err
+		}
+		epc.grpcProxy = gp
+	}
+
 	if cfg.rollingStart {
 		if err := epc.RollingStart(); err != nil {
 			return nil, err
@@ -196,6 +230,13 @@ func newEtcdProcessCluster(t testing.TB, cfg *etcdProcessClusterConfig) (*etcdPr
 			return nil, err
 		}
 	}
+
+	if cfg.clientProxy {
+		if err := epc.startClientProxies(); err != nil {
+			epc.Close()
+			return nil, err
+		}
+	}
 	return epc, nil
 }
 
@@ -312,6 +353,9 @@ func (cfg *etcdProcessClusterConfig) etcdServerProcessConfigs() []*etcdServerPro
 			acurl:        curl,
 			murl:         murl,
 			initialToken: cfg.initialToken,
+			envVars:      cfg.memberEnvVariables(i),
+
+			resourceMonitorInterval: cfg.resourceMonitorInterval,
 		}
 	}
 
@@ -324,6 +368,35 @@ func (cfg *etcdProcessClusterConfig) etcdServerProcessConfigs() []*etcdServerPro
 	return etcdCfgs
 }
 
+// grpcProxyPort returns the port the grpc-proxy listens on,
+// right past the ports used by the members.
+func (cfg *etcdProcessClusterConfig) grpcProxyPort() int {
+	return cfg.basePort + 5*cfg.clusterSize
+}
+
+// clientProxyPort returns the port of the proxy in front of the i-th
+// member's client URL, right past the grpc-proxy port.
+func (cfg *etcdProcessClusterConfig) clientProxyPort(i int) int {
+	return cfg.grpcProxyPort() + 1 + i
+}
+
+// memberEnvVariables returns the environment variables for the i-th member.
+func (cfg *etcdProcessClusterConfig) memberEnvVariables(i int) map[string]string {
+	if len(cfg.envVars) == 0 && (i >= len(cfg.memberEnvVars) || len(cfg.memberEnvVars[i]) == 0) {
+		return nil
+	}
+	env := make(map[string]string, len(cfg.envVars))
+	for k, v := range cfg.envVars {
+		env[k] = v
+	}
+	if i < len(cfg.memberEnvVars) {
+		for k, v := range cfg.memberEnvVars[i] {
+			env[k] = v
+		}
+	}
+	return env
+}
+
 func (cfg *etcdProcessClusterConfig) tlsArgs() (args []string) {
 	if cfg.clientTLS != clientNonTLS {
 		if cfg.isClientAutoTLS {
@@ -374,6 +447,14 @@ func (epc *etcdProcessCluster) EndpointsV3() []string {
 	return epc.endpoints(func(ep etcdProcess) []string { return ep.EndpointsV3() })
 }
 
+// GRPCProxyEndpoints returns the client endpoints of the cluster's grpc-proxy.
+func (epc *etcdProcessCluster) GRPCProxyEndpoints() []string {
+	if epc.grpcProxy == nil {
+		return nil
+	}
+	return epc.grpcProxy.EndpointsV3()
+}
+
 func (epc *etcdProcessCluster) endpoints(f func(ep etcdProcess) []string) (ret []string) {
 	for _, p := range epc.procs {
 		ret = append(ret, f(p)...)
@@ -404,7 +485,7 @@ func (epc *etcdProcessCluster) start(f func(ep etcdProcess) error) error {
 			return err
 		}
 	}
-	return nil
+	return epc.restartGRPCProxy()
 }
 
 func (epc *etcdProcessCluster) rollingStart(f func(ep etcdProcess) error) error {
@@ -420,10 +501,23 @@ func (epc *etcdProcessCluster) rollingStart(f func(ep etcdProcess) error) error
 			return err
 		}
 	}
+	return epc.restartGRPCProxy()
+}
+
+// restartGRPCProxy (re)starts the grpc-proxy, if any, once all members are ready.
+func (epc *etcdProcessCluster) restartGRPCProxy() error {
+	if epc.grpcProxy == nil {
+		return nil
+	}
+	if err := epc.grpcProxy.Restart(); err != nil {
+		epc.Close()
+		return err
+	}
 	return nil
 }
 
 func (epc *etcdProcessCluster) Stop() (err error) {
+	err = epc.grpcProxy.Stop()
 	for _, p := range epc.procs {
 		if p == nil {
 			continue
@@ -441,6 +535,9 @@ func (epc *etcdProcessCluster) Stop() (err error) {
 
 func (epc *etcdProcessCluster) Close() error {
 	err := epc.Stop()
+	if perr := epc.closeClientProxies(); perr != nil {
+		err = perr
+	}
 	for _, p := range epc.procs {
 		// p is nil when newEtcdProcess fails in the middle
 		// Close still gets called to clean up test data
@@ -0,0 +1,89 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestEtcdClientProxyBlackhole checks that client requests sent through
+// a client proxy fail while the proxy drops traffic and succeed again
+// once it is restored.
+func TestEtcdClientProxyBlackhole(t *testing.T) {
+	cfg := configStandalone(*newConfigNoTLS())
+	cfg.clientProxy = true
+	epc, err := newEtcdProcessCluster(t, cfg)
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if err := epc.Close(); err != nil {
+			t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	putArgs := []string{ctlBinPath + "3", "--endpoints", epc.ClientProxyEndpoints()[0], "--dial-timeout", "1s", "--command-timeout", "1s", "put", "foo", "bar"}
+	if err = spawnWithExpect(putArgs, "OK"); err != nil {
+		t.Fatalf("failed to put through client proxy (%v)", err)
+	}
+
+	p := epc.ClientProxy(0)
+	p.BlackholeTx()
+	p.BlackholeRx()
+	if err = spawnWithExpect(putArgs, "context deadline exceeded"); err != nil {
+		t.Fatalf("expected put through blackholed client proxy to time out (%v)", err)
+	}
+
+	p.UnblackholeTx()
+	p.UnblackholeRx()
+	if err = spawnWithExpect(putArgs, "OK"); err != nil {
+		t.Fatalf("failed to put through restored client proxy (%v)", err)
+	}
+}
+
+// TestEtcdClientProxyFailover checks that clients given all proxied
+// endpoints keep serving requests when one member's client traffic is cut.
+func TestEtcdClientProxyFailover(t *testing.T) {
+	cfg := newConfigNoTLS()
+	cfg.clientProxy = true
+	epc, err := newEtcdProcessCluster(t, cfg)
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if err := epc.Close(); err != nil {
+			t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	p := epc.ClientProxy(0)
+	p.BlackholeTx()
+	p.BlackholeRx()
+
+	eps := strings.Join(epc.ClientProxyEndpoints(), ",")
+	for i := 0; i < 5; i++ {
+		key := fmt.Sprintf("foo%d", i)
+		putArgs := []string{ctlBinPath + "3", "--endpoints", eps, "--dial-timeout", "5s", "--command-timeout", "5s", "put", key, "bar"}
+		if err = spawnWithExpect(putArgs, "OK"); err != nil {
+			t.Fatalf("failed to put %q with one client proxy blackholed (%v)", key, err)
+		}
+		getArgs := []string{ctlBinPath + "3", "--endpoints", eps, "--dial-timeout", "5s", "--command-timeout", "5s", "get", key}
+		if err = spawnWithExpects(getArgs, key, "bar"); err != nil {
+			t.Fatalf("failed to get %q with one client proxy blackholed (%v)", key, err)
+		}
+	}
+}
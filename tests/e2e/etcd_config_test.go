@@ -310,3 +310,23 @@ func TestGrpcproxyAndCommonName(t *testing.T) {
 	}
 	p.Stop()
 }
+
+// TestEtcdMemberEnvVars checks that per-member environment variables
+// are passed to the member process.
+func TestEtcdMemberEnvVars(t *testing.T) {
+	cfg := configStandalone(*newConfigNoTLS())
+	cfg.memberEnvVars = []map[string]string{{"ETCD_ENABLE_PPROF": "true"}}
+	epc, err := newEtcdProcessCluster(t, cfg)
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if err := epc.Close(); err != nil {
+			t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	if err := cURLGet(epc, cURLReq{endpoint: "/debug/pprof/", expected: "goroutine"}); err != nil {
+		t.Fatalf("failed get with curl (%v)", err)
+	}
+}
@@ -81,7 +81,7 @@ func corruptTest(cx ctlCtx) {
 	}
 
 	ep := cx.epc.procs[0]
-	proc, err := spawnCmd(append([]string{ep.Config().execPath}, ep.Config().args...))
+	proc, err := spawnCmdWithEnv(append([]string{ep.Config().execPath}, ep.Config().args...), ep.Config().envVars)
 	if err != nil {
 		cx.t.Fatal(err)
 	}
@@ -0,0 +1,105 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestGRPCProxyNamespace checks that keys written through a namespaced
+// grpc-proxy are stored under the namespace prefix.
+func TestGRPCProxyNamespace(t *testing.T) {
+	cfg := newConfigNoTLS()
+	cfg.grpcProxy = &grpcProxyConfig{namespace: "ns/"}
+	epc, err := newEtcdProcessCluster(t, cfg)
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if err := epc.Close(); err != nil {
+			t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	proxyEps := strings.Join(epc.GRPCProxyEndpoints(), ",")
+	if err = spawnWithExpect([]string{ctlBinPath + "3", "--endpoints", proxyEps, "put", "foo", "bar"}, "OK"); err != nil {
+		t.Fatalf("failed to put through grpc-proxy (%v)", err)
+	}
+	if err = spawnWithExpects([]string{ctlBinPath + "3", "--endpoints", proxyEps, "get", "foo"}, "foo", "bar"); err != nil {
+		t.Fatalf("failed to get through grpc-proxy (%v)", err)
+	}
+
+	memberEps := strings.Join(epc.EndpointsV3(), ",")
+	if err = spawnWithExpects([]string{ctlBinPath + "3", "--endpoints", memberEps, "get", "ns/foo"}, "ns/foo", "bar"); err != nil {
+		t.Fatalf("failed to get namespaced key from members (%v)", err)
+	}
+}
+
+// TestGRPCProxyLeasingAndOrdering checks that a grpc-proxy started with
+// the experimental leasing and serializable ordering options serves requests.
+func TestGRPCProxyLeasingAndOrdering(t *testing.T) {
+	cfg := newConfigNoTLS()
+	cfg.grpcProxy = &grpcProxyConfig{leasingPrefix: "leasing/", serializableOrdering: true}
+	epc, err := newEtcdProcessCluster(t, cfg)
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if err := epc.Close(); err != nil {
+			t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	proxyEps := strings.Join(epc.GRPCProxyEndpoints(), ",")
+	if err = spawnWithExpect([]string{ctlBinPath + "3", "--endpoints", proxyEps, "put", "foo", "bar"}, "OK"); err != nil {
+		t.Fatalf("failed to put through grpc-proxy (%v)", err)
+	}
+	if err = spawnWithExpects([]string{ctlBinPath + "3", "--endpoints", proxyEps, "get", "foo", "--consistency", "s"}, "foo", "bar"); err != nil {
+		t.Fatalf("failed to get through grpc-proxy (%v)", err)
+	}
+}
+
+// TestGRPCProxyClusterRestart checks that the grpc-proxy is started again
+// when the cluster is stopped and restarted.
+func TestGRPCProxyClusterRestart(t *testing.T) {
+	cfg := newConfigNoTLS()
+	cfg.grpcProxy = &grpcProxyConfig{}
+	epc, err := newEtcdProcessCluster(t, cfg)
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if err := epc.Close(); err != nil {
+			t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	proxyEps := strings.Join(epc.GRPCProxyEndpoints(), ",")
+	if err = spawnWithExpect([]string{ctlBinPath + "3", "--endpoints", proxyEps, "put", "foo", "bar"}, "OK"); err != nil {
+		t.Fatalf("failed to put through grpc-proxy (%v)", err)
+	}
+
+	if err = epc.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err = epc.Restart(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = spawnWithExpects([]string{ctlBinPath + "3", "--endpoints", proxyEps, "get", "foo"}, "foo", "bar"); err != nil {
+		t.Fatalf("failed to get through grpc-proxy after restart (%v)", err)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// zapTimeLayout matches zapcore.ISO8601TimeEncoder used by etcd loggers.
+const zapTimeLayout = "2006-01-02T15:04:05.000Z0700"
+
+// logEntry is a single zap JSON log line emitted by an etcd process.
+type logEntry struct {
+	Level  zapcore.Level
+	Time   time.Time
+	Logger string
+	Caller string
+	Msg    string
+	// Fields holds every key of the entry that is not one of the above.
+	Fields map[string]interface{}
+	// Raw is the original log line without the trailing line break.
+	Raw string
+}
+
+// parseLogEntry parses a zap JSON log line. It returns false for lines
+// that are not zap JSON, such as panic traces.
+func parseLogEntry(line string) (logEntry, bool) {
+	raw := strings.TrimRight(line, "\r\n")
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		return logEntry{}, false
+	}
+	e := logEntry{Fields: fields, Raw: raw}
+	lvl, ok := fields["level"].(string)
+	if !ok || e.Level.UnmarshalText([]byte(lvl)) != nil {
+		return logEntry{}, false
+	}
+	if ts, ok := fields["ts"].(string); ok {
+		e.Time, _ = time.Parse(zapTimeLayout, ts)
+	}
+	e.Logger, _ = fields["logger"].(string)
+	e.Caller, _ = fields["caller"].(string)
+	e.Msg, _ = fields["msg"].(string)
+	for _, k := range []string{"level", "ts", "logger", "caller", "msg"} {
+		delete(fields, k)
+	}
+	return e, true
+}
+
+func parseLogEntries(lines []string) (logs memberLogs) {
+	for _, l := range lines {
+		if e, ok := parseLogEntry(l); ok {
+			logs = append(logs, e)
+		}
+	}
+	return logs
+}
+
+// memberLogs holds the structured logs of an etcd process in emission order.
+type memberLogs []logEntry
+
+// logFilter selects log entries in memberLogs.Query.
+type logFilter func(e logEntry) bool
+
+// withLogMinLevel selects entries logged at lvl or above.
+func withLogMinLevel(lvl zapcore.Level) logFilter {
+	return func(e logEntry) bool { return e.Level >= lvl }
+}
+
+// withLogger selects entries emitted by the named logger.
+func withLogger(name string) logFilter {
+	return func(e logEntry) bool { return e.Logger == name }
+}
+
+// withLogMsg selects entries whose message contains s.
+func withLogMsg(s string) logFilter {
+	return func(e logEntry) bool { return strings.Contains(e.Msg, s) }
+}
+
+// withLogTimeRange selects entries logged within [since, until].
+// A zero since or until leaves that end of the range open.
+func withLogTimeRange(since, until time.Time) logFilter {
+	return func(e logEntry) bool {
+		return (since.IsZero() || !e.Time.Before(since)) && (until.IsZero() || !e.Time.After(until))
+	}
+}
+
+// Query returns the entries matching all filters.
+func (logs memberLogs) Query(filters ...logFilter) (ret memberLogs) {
+	for _, e := range logs {
+		matched := true
+		for _, f := range filters {
+			if !f(e) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
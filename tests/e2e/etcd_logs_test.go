@@ -0,0 +1,57 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// TestEtcdServerLogs checks that member logs are captured as structured
+// entries across restarts and can be queried.
+func TestEtcdServerLogs(t *testing.T) {
+	epc, err := newEtcdProcessCluster(t, configStandalone(*newConfigNoTLS()))
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if err := epc.Close(); err != nil {
+			t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	proc := epc.procs[0]
+	published := withLogMsg("published local member to cluster through raft")
+	if n := len(proc.Logs().Query(published)); n != 1 {
+		t.Fatalf("expected 1 publish log entry, got %d", n)
+	}
+
+	restartTime := time.Now().Add(-time.Millisecond)
+	if err = proc.Restart(); err != nil {
+		t.Fatal(err)
+	}
+	logs := proc.Logs()
+	if n := len(logs.Query(published)); n != 2 {
+		t.Fatalf("expected 2 publish log entries after restart, got %d", n)
+	}
+	if n := len(logs.Query(published, withLogTimeRange(restartTime, time.Time{}))); n != 1 {
+		t.Fatalf("expected 1 publish log entry since restart, got %d", n)
+	}
+	if n := len(logs.Query(published, withLogMinLevel(zapcore.WarnLevel))); n != 0 {
+		t.Fatalf("expected no publish log entry at warn level, got %d", n)
+	}
+}
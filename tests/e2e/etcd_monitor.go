@@ -0,0 +1,164 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// userHZ is the clock tick rate /proc reports CPU times in on Linux.
+const userHZ = 100
+
+// resourceUsage is a sample of the resources used by an etcd process.
+type resourceUsage struct {
+	Time time.Time
+	// CPUTime is the user and system CPU time consumed so far.
+	CPUTime      time.Duration
+	RSSBytes     int64
+	OpenFDs      int
+	DataDirBytes int64
+}
+
+// resourceMonitor periodically samples the resource usage of a process
+// and the size of its data directory. It relies on /proc and thus
+// only collects samples on Linux.
+type resourceMonitor struct {
+	pid     int
+	dataDir string
+
+	mu      sync.Mutex
+	samples []resourceUsage
+
+	stopc chan struct{}
+	donec chan struct{}
+}
+
+func startResourceMonitor(pid int, dataDir string, interval time.Duration) *resourceMonitor {
+	m := &resourceMonitor{
+		pid:     pid,
+		dataDir: dataDir,
+		stopc:   make(chan struct{}),
+		donec:   make(chan struct{}),
+	}
+	go m.run(interval)
+	return m
+}
+
+func (m *resourceMonitor) run(interval time.Duration) {
+	defer close(m.donec)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		m.sample()
+		select {
+		case <-ticker.C:
+		case <-m.stopc:
+			return
+		}
+	}
+}
+
+func (m *resourceMonitor) sample() {
+	u, err := processResourceUsage(m.pid)
+	if err != nil {
+		// process exited between samples
+		return
+	}
+	u.DataDirBytes = dirSize(m.dataDir)
+	m.mu.Lock()
+	m.samples = append(m.samples, u)
+	m.mu.Unlock()
+}
+
+// stop stops sampling and waits for the monitor goroutine to exit.
+func (m *resourceMonitor) stop() {
+	close(m.stopc)
+	<-m.donec
+}
+
+// Samples returns the collected samples in the order they were taken.
+func (m *resourceMonitor) Samples() []resourceUsage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]resourceUsage(nil), m.samples...)
+}
+
+func processResourceUsage(pid int) (resourceUsage, error) {
+	u := resourceUsage{Time: time.Now()}
+
+	stat, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return u, err
+	}
+	// skip pid and command name, which may contain spaces
+	i := strings.LastIndexByte(string(stat), ')')
+	if i < 0 {
+		return u, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	fields := strings.Fields(string(stat[i+1:]))
+	if len(fields) < 13 {
+		return u, fmt.Errorf("malformed /proc/%d/stat", pid)
+	}
+	// utime and stime are the 14th and 15th fields
+	utime, err := strconv.ParseInt(fields[11], 10, 64)
+	if err != nil {
+		return u, err
+	}
+	stime, err := strconv.ParseInt(fields[12], 10, 64)
+	if err != nil {
+		return u, err
+	}
+	u.CPUTime = time.Duration(utime+stime) * time.Second / userHZ
+
+	statm, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/statm", pid))
+	if err != nil {
+		return u, err
+	}
+	pages := strings.Fields(string(statm))
+	if len(pages) < 2 {
+		return u, fmt.Errorf("malformed /proc/%d/statm", pid)
+	}
+	rss, err := strconv.ParseInt(pages[1], 10, 64)
+	if err != nil {
+		return u, err
+	}
+	u.RSSBytes = rss * int64(os.Getpagesize())
+
+	fds, err := ioutil.ReadDir(fmt.Sprintf("/proc/%d/fd", pid))
+	if err != nil {
+		return u, err
+	}
+	u.OpenFDs = len(fds)
+	return u, nil
+}
+
+// dirSize returns the total size of the regular files under dir,
+// ignoring files removed while walking.
+func dirSize(dir string) (size int64) {
+	filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
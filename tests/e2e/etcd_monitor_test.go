@@ -0,0 +1,69 @@
+// Copyright 2021 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestEtcdResourceMonitor checks that resource usage samples are collected
+// for a member and kept across restarts.
+func TestEtcdResourceMonitor(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("resource monitor requires /proc")
+	}
+	cfg := configStandalone(*newConfigNoTLS())
+	cfg.resourceMonitorInterval = 50 * time.Millisecond
+	epc, err := newEtcdProcessCluster(t, cfg)
+	if err != nil {
+		t.Fatalf("could not start etcd process cluster (%v)", err)
+	}
+	defer func() {
+		if err := epc.Close(); err != nil {
+			t.Fatalf("error closing etcd processes (%v)", err)
+		}
+	}()
+
+	time.Sleep(300 * time.Millisecond)
+	proc := epc.procs[0]
+	before := proc.ResourceUsage()
+	if len(before) == 0 {
+		t.Fatal("expected samples before restart")
+	}
+	if err = proc.Restart(); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	samples := proc.ResourceUsage()
+	if len(samples) <= len(before) {
+		t.Fatalf("expected more than %d samples after restart, got %d", len(before), len(samples))
+	}
+	if !reflect.DeepEqual(samples[:len(before)], before) {
+		t.Fatalf("samples taken before restart were not kept, first sample %+v, expected %+v", samples[0], before[0])
+	}
+	last := samples[len(samples)-1]
+	if last.RSSBytes <= 0 || last.OpenFDs <= 0 || last.DataDirBytes <= 0 {
+		t.Fatalf("unexpected resource usage sample %+v", last)
+	}
+	for i := 1; i < len(samples); i++ {
+		if samples[i].Time.Before(samples[i-1].Time) {
+			t.Fatalf("samples out of order: %+v before %+v", samples[i-1], samples[i])
+		}
+	}
+}
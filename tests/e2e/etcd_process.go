@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"time"
 
 	"go.etcd.io/etcd/pkg/v3/expect"
 	"go.etcd.io/etcd/pkg/v3/fileutil"
@@ -41,18 +42,26 @@ type etcdProcess interface {
 	Close() error
 	WithStopSignal(sig os.Signal) os.Signal
 	Config() *etcdServerProcessConfig
+	Logs() memberLogs
+	ResourceUsage() []resourceUsage
 }
 
 type etcdServerProcess struct {
 	cfg   *etcdServerProcessConfig
 	proc  *expect.ExpectProcess
 	donec chan struct{} // closed when Interact() terminates
+
+	stoppedLines []string // output of previously stopped processes
+
+	monitor      *resourceMonitor // nil unless resourceMonitorInterval is set
+	stoppedUsage []resourceUsage  // samples of previously stopped processes
 }
 
 type etcdServerProcessConfig struct {
 	execPath string
 	args     []string
 	tlsArgs  []string
+	envVars  map[string]string
 
 	dataDirPath string
 	keepDataDir bool
@@ -66,6 +75,8 @@ type etcdServerProcessConfig struct {
 
 	initialToken   string
 	initialCluster string
+
+	resourceMonitorInterval time.Duration
 }
 
 func newEtcdServerProcess(cfg *etcdServerProcessConfig) (*etcdServerProcess, error) {
@@ -88,11 +99,14 @@ func (ep *etcdServerProcess) Start() error {
 	if ep.proc != nil {
 		panic("already started")
 	}
-	proc, err := spawnCmd(append([]string{ep.cfg.execPath}, ep.cfg.args...))
+	proc, err := spawnCmdWithEnv(append([]string{ep.cfg.execPath}, ep.cfg.args...), ep.cfg.envVars, expect.WithRetainedLines())
 	if err != nil {
 		return err
 	}
 	ep.proc = proc
+	if ep.cfg.resourceMonitorInterval > 0 {
+		ep.monitor = startResourceMonitor(proc.Pid(), ep.cfg.dataDirPath, ep.cfg.resourceMonitorInterval)
+	}
 	return ep.waitReady()
 }
 
@@ -108,13 +122,20 @@ func (ep *etcdServerProcess) Stop() (err error) {
 	if ep == nil || ep.proc == nil {
 		return nil
 	}
-	err = ep.proc.Stop()
-	if err != nil {
-		return err
+	if ep.monitor != nil {
+		ep.monitor.stop()
+		ep.stoppedUsage = append(ep.stoppedUsage, ep.monitor.Samples()...)
+		ep.monitor = nil
 	}
+	err = ep.proc.Stop()
+	// the process has exited even if it did not stop cleanly
+	ep.stoppedLines = append(ep.stoppedLines, ep.proc.Lines()...)
 	ep.proc = nil
 	<-ep.donec
 	ep.donec = make(chan struct{})
+	if err != nil {
+		return err
+	}
 	if ep.cfg.purl.Scheme == "unix" || ep.cfg.purl.Scheme == "unixs" {
 		err = os.Remove(ep.cfg.purl.Host + ep.cfg.purl.Path)
 		if err != nil && !os.IsNotExist(err) {
@@ -143,3 +164,23 @@ func (ep *etcdServerProcess) waitReady() error {
 }
 
 func (ep *etcdServerProcess) Config() *etcdServerProcessConfig { return ep.cfg }
+
+// Logs returns the structured logs emitted by the process, including
+// the logs of runs before the last restart.
+func (ep *etcdServerProcess) Logs() memberLogs {
+	lines := ep.stoppedLines
+	if ep.proc != nil {
+		lines = append(append([]string(nil), lines...), ep.proc.Lines()...)
+	}
+	return parseLogEntries(lines)
+}
+
+// ResourceUsage returns the resource usage samples collected for the
+// process, including the samples of runs before the last restart.
+func (ep *etcdServerProcess) ResourceUsage() []resourceUsage {
+	usage := ep.stoppedUsage
+	if ep.monitor != nil {
+		usage = append(append([]resourceUsage(nil), usage...), ep.monitor.Samples()...)
+	}
+	return usage
+}
@@ -31,6 +31,10 @@ import (
 const noOutputLineCount = 2 // cov-enabled binaries emit PASS and coverage count lines
 
 func spawnCmd(args []string) (*expect.ExpectProcess, error) {
+	return spawnCmdWithEnv(args, nil)
+}
+
+func spawnCmdWithEnv(args []string, envVars map[string]string, opts ...expect.ExpectOption) (*expect.ExpectProcess, error) {
 	cmd := args[0]
 	env := make([]string, 0)
 	switch cmd {
@@ -50,9 +54,12 @@ func spawnCmd(args []string) (*expect.ExpectProcess, error) {
 	// when withFlagByEnv() is used in testCtl(), env variables for ctl is set to os.env.
 	// they must be included in ctl_cov_env.
 	env = append(env, os.Environ()...)
+	for k, v := range envVars {
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
+	}
 	all_args := append(args[1:], covArgs...)
 	log.Printf("Executing %v %v", cmd, all_args)
-	ep, err := expect.NewExpectWithEnv(cmd, all_args, env)
+	ep, err := expect.NewExpectWithEnv(cmd, all_args, env, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@
 package e2e
 
 import (
+	"fmt"
 	"os"
 
 	"go.etcd.io/etcd/pkg/v3/expect"
@@ -25,9 +26,27 @@ import (
 const noOutputLineCount = 0 // regular binaries emit no extra lines
 
 func spawnCmd(args []string) (*expect.ExpectProcess, error) {
+	return spawnCmdWithEnv(args, nil)
+}
+
+func spawnCmdWithEnv(args []string, envVars map[string]string, opts ...expect.ExpectOption) (*expect.ExpectProcess, error) {
 	if args[0] == ctlBinPath+"3" {
-		env := append(os.Environ(), "ETCDCTL_API=3")
-		return expect.NewExpectWithEnv(ctlBinPath, args[1:], env)
+		env := append(mergeEnvVariables(envVars), "ETCDCTL_API=3")
+		return expect.NewExpectWithEnv(ctlBinPath, args[1:], env, opts...)
+	}
+	if len(envVars) == 0 {
+		// nil env uses the current system env
+		return expect.NewExpectWithEnv(args[0], args[1:], nil, opts...)
+	}
+	return expect.NewExpectWithEnv(args[0], args[1:], mergeEnvVariables(envVars), opts...)
+}
+
+// mergeEnvVariables returns the current process environment
+// with envVars appended, so that envVars take precedence.
+func mergeEnvVariables(envVars map[string]string) []string {
+	env := os.Environ()
+	for k, v := range envVars {
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
 	}
-	return expect.NewExpect(args[0], args[1:]...)
+	return env
 }
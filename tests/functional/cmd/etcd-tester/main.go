@@ -17,6 +17,7 @@ package main
 
 import (
 	"flag"
+	"strings"
 
 	_ "github.com/etcd-io/gofail/runtime"
 	"go.etcd.io/etcd/tests/v3/functional/tester"
@@ -35,6 +36,9 @@ func init() {
 
 func main() {
 	config := flag.String("config", "", "path to tester configuration")
+	cases := flag.String("cases", "", "comma separated list of test cases to run, overriding the configuration")
+	seed := flag.Int64("seed", 0, "seed for case shuffling and randomized test options, overriding the configuration")
+	reportDir := flag.String("report-dir", "", "directory to save the case report to, overriding the configuration")
 	flag.Parse()
 
 	defer logger.Sync()
@@ -43,6 +47,17 @@ func main() {
 	if err != nil {
 		logger.Fatal("failed to create a cluster", zap.Error(err))
 	}
+	if *cases != "" {
+		if err = clus.SetCases(strings.Split(*cases, ",")); err != nil {
+			logger.Fatal("failed to set cases", zap.Error(err))
+		}
+	}
+	if *seed != 0 {
+		clus.Tester.Seed = *seed
+	}
+	if *reportDir != "" {
+		clus.Tester.ReportDir = *reportDir
+	}
 
 	err = clus.Send_INITIAL_START_ETCD()
 	if err != nil {
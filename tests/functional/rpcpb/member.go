@@ -211,6 +211,21 @@ func (m *Member) Rev(ctx context.Context) (int64, error) {
 	return resp.Header.Revision, nil
 }
 
+// RaftIndex fetches current raft index on this member.
+func (m *Member) RaftIndex(ctx context.Context) (uint64, error) {
+	cli, err := m.CreateEtcdClient()
+	if err != nil {
+		return 0, fmt.Errorf("%v (%q)", err, m.EtcdClientEndpoint)
+	}
+	defer cli.Close()
+
+	resp, err := cli.Status(ctx, m.EtcdClientEndpoint)
+	if err != nil {
+		return 0, err
+	}
+	return resp.RaftIndex, nil
+}
+
 // Compact compacts member storage with given revision.
 // It blocks until it's physically done.
 func (m *Member) Compact(rev int64, timeout time.Duration) error {
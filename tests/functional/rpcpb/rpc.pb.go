@@ -817,6 +817,9 @@ type Tester struct {
 	DataDir string `protobuf:"bytes,1,opt,name=DataDir,proto3" json:"DataDir,omitempty" yaml:"data-dir"`
 	Network string `protobuf:"bytes,2,opt,name=Network,proto3" json:"Network,omitempty" yaml:"network"`
 	Addr    string `protobuf:"bytes,3,opt,name=Addr,proto3" json:"Addr,omitempty" yaml:"addr"`
+	// ReportDir is the directory to save the case report to when the tester exits.
+	// Leave empty to only print the report.
+	ReportDir string `protobuf:"bytes,4,opt,name=ReportDir,proto3" json:"ReportDir,omitempty" yaml:"report-dir"`
 	// DelayLatencyMsRv is the delay latency in milliseconds,
 	// to inject to simulated slow network.
 	DelayLatencyMs uint32 `protobuf:"varint,11,opt,name=DelayLatencyMs,proto3" json:"DelayLatencyMs,omitempty" yaml:"delay-latency-ms"`
@@ -832,6 +835,13 @@ type Tester struct {
 	ExitOnCaseFail bool `protobuf:"varint,22,opt,name=ExitOnCaseFail,proto3" json:"ExitOnCaseFail,omitempty" yaml:"exit-on-failure"`
 	// EnablePprof is true to enable profiler.
 	EnablePprof bool `protobuf:"varint,23,opt,name=EnablePprof,proto3" json:"EnablePprof,omitempty" yaml:"enable-pprof"`
+	// RandomInitialCorruptCheck is true to randomly enable or disable
+	// initial corruption check on each member at the start of every round.
+	// The new setting takes effect when the member is restarted.
+	RandomInitialCorruptCheck bool `protobuf:"varint,24,opt,name=RandomInitialCorruptCheck,proto3" json:"RandomInitialCorruptCheck,omitempty" yaml:"random-initial-corrupt-check"`
+	// Seed is the seed for case shuffling and other randomized test options
+	// (0 to seed with current time).
+	Seed int64 `protobuf:"varint,25,opt,name=Seed,proto3" json:"Seed,omitempty" yaml:"seed"`
 	// CaseDelayMs is the delay duration after failure is injected.
 	// Useful when triggering snapshot or no-op failure cases.
 	CaseDelayMs uint32 `protobuf:"varint,31,opt,name=CaseDelayMs,proto3" json:"CaseDelayMs,omitempty" yaml:"case-delay-ms"`
@@ -843,10 +853,32 @@ type Tester struct {
 	// FailpointCommands is the list of "gofail" commands
 	// (e.g. panic("etcd-tester"),1*sleep(1000).
 	FailpointCommands []string `protobuf:"bytes,34,rep,name=FailpointCommands,proto3" json:"FailpointCommands,omitempty" yaml:"failpoint-commands"`
+	// InjectDelayMs is the delay duration after stressers start
+	// before failure is injected.
+	InjectDelayMs uint32 `protobuf:"varint,35,opt,name=InjectDelayMs,proto3" json:"InjectDelayMs,omitempty" yaml:"inject-delay-ms"`
+	// InjectAtRevision is the cluster revision to wait for, while stressing,
+	// before failure is injected (0 to skip the wait).
+	InjectAtRevision int64 `protobuf:"varint,36,opt,name=InjectAtRevision,proto3" json:"InjectAtRevision,omitempty" yaml:"inject-at-revision"`
+	// InjectRepeat is the number of times to inject and recover each case
+	// while stressing (0 to inject once).
+	InjectRepeat uint32 `protobuf:"varint,37,opt,name=InjectRepeat,proto3" json:"InjectRepeat,omitempty" yaml:"inject-repeat"`
+	// InjectIntervalMs is the delay duration after a case is recovered
+	// before it is injected again.
+	InjectIntervalMs uint32 `protobuf:"varint,38,opt,name=InjectIntervalMs,proto3" json:"InjectIntervalMs,omitempty" yaml:"inject-interval-ms"`
+	// RecoveryMaxMs is the maximum duration from the start of recovery
+	// until all members are healthy and stressers reach ReadyMinStressQPS again.
+	// A round fails if recovery takes longer (0 for no limit).
+	RecoveryMaxMs uint32 `protobuf:"varint,39,opt,name=RecoveryMaxMs,proto3" json:"RecoveryMaxMs,omitempty" yaml:"recovery-max-ms"`
 	// RunnerExecPath is a path of etcd-runner binary.
 	RunnerExecPath string `protobuf:"bytes,41,opt,name=RunnerExecPath,proto3" json:"RunnerExecPath,omitempty" yaml:"runner-exec-path"`
 	// ExternalExecPath is a path of script for enabling/disabling an external fault injector.
 	ExternalExecPath string `protobuf:"bytes,42,opt,name=ExternalExecPath,proto3" json:"ExternalExecPath,omitempty" yaml:"external-exec-path"`
+	// ReadyMaxRaftIndexSkew is the maximum raft index difference between
+	// members before failure is injected (0 to skip the check).
+	ReadyMaxRaftIndexSkew uint64 `protobuf:"varint,51,opt,name=ReadyMaxRaftIndexSkew,proto3" json:"ReadyMaxRaftIndexSkew,omitempty" yaml:"ready-max-raft-index-skew"`
+	// ReadyMinStressQPS is the minimum number of stresser requests per second
+	// before failure is injected (0 to skip the check).
+	ReadyMinStressQPS int32 `protobuf:"varint,52,opt,name=ReadyMinStressQPS,proto3" json:"ReadyMinStressQPS,omitempty" yaml:"ready-min-stress-qps"`
 	// Stressers is the list of stresser types:
 	// KV, LEASE, ELECTION_RUNNER, WATCH_RUNNER, LOCK_RACER_RUNNER, LEASE_RUNNER.
 	Stressers []*Stresser `protobuf:"bytes,101,rep,name=Stressers,proto3" json:"Stressers,omitempty" yaml:"stressers"`
@@ -870,7 +902,10 @@ type Tester struct {
 	// with "one" shared TCP connection.
 	StressClients int32 `protobuf:"varint,301,opt,name=StressClients,proto3" json:"StressClients,omitempty" yaml:"stress-clients"`
 	// StressQPS is the maximum number of stresser requests per second.
-	StressQPS            int32    `protobuf:"varint,302,opt,name=StressQPS,proto3" json:"StressQPS,omitempty" yaml:"stress-qps"`
+	StressQPS int32 `protobuf:"varint,302,opt,name=StressQPS,proto3" json:"StressQPS,omitempty" yaml:"stress-qps"`
+	// StressBackoffQPS is the maximum number of stresser requests per second
+	// while a failure is injected and recovered (0 to keep StressQPS).
+	StressBackoffQPS     int32    `protobuf:"varint,303,opt,name=StressBackoffQPS,proto3" json:"StressBackoffQPS,omitempty" yaml:"stress-backoff-qps"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1040,195 +1075,212 @@ func init() {
 func init() { proto.RegisterFile("rpcpb/rpc.proto", fileDescriptor_4fbc93a8dcc3881e) }
 
 var fileDescriptor_4fbc93a8dcc3881e = []byte{
-	// 3003 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x59, 0xcb, 0x77, 0xdb, 0xc6,
-	0xf5, 0x36, 0x44, 0x49, 0x96, 0x46, 0x2f, 0x6a, 0x64, 0xd9, 0xf0, 0x4b, 0x90, 0xe1, 0x38, 0x3f,
-	0x59, 0x09, 0x6c, 0xff, 0xec, 0x9c, 0x3c, 0x9c, 0x26, 0x0e, 0x48, 0x41, 0x12, 0x2b, 0x88, 0xa4,
-	0x87, 0x90, 0xec, 0x74, 0x83, 0x03, 0x91, 0x23, 0x09, 0xc7, 0x14, 0xc0, 0x00, 0x43, 0x47, 0xca,
-	0x3f, 0xd0, 0x5d, 0x4f, 0xdf, 0xa7, 0x3d, 0xa7, 0x7f, 0x42, 0xd3, 0x2e, 0xba, 0xee, 0xde, 0x79,
-	0xb5, 0x69, 0xbb, 0x6a, 0x17, 0x3c, 0x6d, 0xba, 0xe9, 0xaa, 0x0b, 0x9e, 0xbe, 0x17, 0x3d, 0x3d,
-	0x33, 0x03, 0x90, 0x03, 0x80, 0x94, 0xbd, 0xb2, 0x70, 0xef, 0xf7, 0x7d, 0x73, 0x71, 0xef, 0x60,
-	0xee, 0x1d, 0x13, 0xcc, 0x05, 0xad, 0x7a, 0x6b, 0xef, 0x76, 0xd0, 0xaa, 0xdf, 0x6a, 0x05, 0x3e,
-	0xf1, 0xe1, 0x18, 0x33, 0x5c, 0xd2, 0x0e, 0x5c, 0x72, 0xd8, 0xde, 0xbb, 0x55, 0xf7, 0x8f, 0x6e,
-	0x1f, 0xf8, 0x07, 0xfe, 0x6d, 0xe6, 0xdd, 0x6b, 0xef, 0xb3, 0x27, 0xf6, 0xc0, 0xfe, 0xe2, 0x2c,
-	0xf5, 0x9b, 0x12, 0x38, 0x8b, 0xf0, 0x07, 0x6d, 0x1c, 0x12, 0x78, 0x0b, 0x4c, 0x56, 0x5a, 0x38,
-	0x70, 0x88, 0xeb, 0x7b, 0xb2, 0xb4, 0x2c, 0xad, 0xcc, 0xde, 0xcd, 0xdf, 0x62, 0xaa, 0xb7, 0x7a,
-	0x76, 0xd4, 0x87, 0xc0, 0x1b, 0x60, 0x7c, 0x1b, 0x1f, 0xed, 0xe1, 0x40, 0x1e, 0x59, 0x96, 0x56,
-	0xa6, 0xee, 0xce, 0x44, 0x60, 0x6e, 0x44, 0x91, 0x93, 0xc2, 0x2c, 0x1c, 0x12, 0x1c, 0xc8, 0xb9,
-	0x04, 0x8c, 0x1b, 0x51, 0xe4, 0x54, 0xff, 0x32, 0x02, 0xa6, 0x6b, 0x9e, 0xd3, 0x0a, 0x0f, 0x7d,
-	0x52, 0xf2, 0xf6, 0x7d, 0xb8, 0x04, 0x00, 0x57, 0x28, 0x3b, 0x47, 0x98, 0xc5, 0x33, 0x89, 0x04,
-	0x0b, 0x5c, 0x05, 0x79, 0xfe, 0x54, 0x6c, 0xba, 0xd8, 0x23, 0x3b, 0xc8, 0x0c, 0xe5, 0x91, 0xe5,
-	0xdc, 0xca, 0x24, 0xca, 0xd8, 0xa1, 0xda, 0xd7, 0xae, 0x3a, 0xe4, 0x90, 0x45, 0x32, 0x89, 0x12,
-	0x36, 0xaa, 0x17, 0x3f, 0xaf, 0xbb, 0x4d, 0x5c, 0x73, 0x3f, 0xc2, 0xf2, 0x28, 0xc3, 0x65, 0xec,
-	0xf0, 0x55, 0x30, 0x1f, 0xdb, 0x2c, 0x9f, 0x38, 0x4d, 0x06, 0x1e, 0x63, 0xe0, 0xac, 0x43, 0x54,
-	0x66, 0xc6, 0x2d, 0x7c, 0x22, 0x8f, 0x2f, 0x4b, 0x2b, 0x39, 0x94, 0xb1, 0x8b, 0x91, 0x6e, 0x3a,
-	0xe1, 0xa1, 0x7c, 0x96, 0xe1, 0x12, 0x36, 0x51, 0x0f, 0xe1, 0xa7, 0x6e, 0x48, 0xeb, 0x35, 0x91,
-	0xd4, 0x8b, 0xed, 0x10, 0x82, 0x51, 0xcb, 0xf7, 0x9f, 0xc8, 0x93, 0x2c, 0x38, 0xf6, 0xb7, 0xfa,
-	0x13, 0x09, 0x4c, 0x20, 0x1c, 0xb6, 0x7c, 0x2f, 0xc4, 0x50, 0x06, 0x67, 0x6b, 0xed, 0x7a, 0x1d,
-	0x87, 0x21, 0xcb, 0xf1, 0x04, 0x8a, 0x1f, 0xe1, 0x79, 0x30, 0x5e, 0x23, 0x0e, 0x69, 0x87, 0xac,
-	0xbe, 0x93, 0x28, 0x7a, 0x12, 0xea, 0x9e, 0x3b, 0xad, 0xee, 0x6f, 0x24, 0xeb, 0xc9, 0x72, 0x39,
-	0x75, 0x77, 0x21, 0x02, 0x8b, 0x2e, 0x94, 0x00, 0xaa, 0x9f, 0x4e, 0xc7, 0x0b, 0xc0, 0x3b, 0x60,
-	0xc2, 0x20, 0xf5, 0x86, 0x71, 0x8c, 0xeb, 0x7c, 0x07, 0x14, 0xce, 0x75, 0x3b, 0x4a, 0xfe, 0xc4,
-	0x39, 0x6a, 0xde, 0x57, 0x31, 0xa9, 0x37, 0x34, 0x7c, 0x8c, 0xeb, 0x2a, 0xea, 0xa1, 0xe0, 0x3d,
-	0x30, 0xa9, 0x1f, 0x60, 0x8f, 0xe8, 0x8d, 0x46, 0x20, 0x4f, 0x31, 0xca, 0x62, 0xb7, 0xa3, 0xcc,
-	0x73, 0x8a, 0x43, 0x5d, 0x9a, 0xd3, 0x68, 0x04, 0x2a, 0xea, 0xe3, 0xa0, 0x09, 0xe6, 0xd7, 0x1d,
-	0xb7, 0xd9, 0xf2, 0x5d, 0x8f, 0x6c, 0x5a, 0x56, 0x95, 0x91, 0xa7, 0x19, 0x79, 0xa9, 0xdb, 0x51,
-	0x2e, 0x71, 0xf2, 0x7e, 0x0c, 0xd1, 0x0e, 0x09, 0x69, 0x45, 0x2a, 0x59, 0x22, 0xd4, 0xc0, 0xd9,
-	0x82, 0x13, 0xe2, 0x35, 0x37, 0x90, 0x31, 0xd3, 0x58, 0xe8, 0x76, 0x94, 0x39, 0xae, 0xb1, 0xe7,
-	0x84, 0x58, 0x6b, 0xb8, 0x81, 0x8a, 0x62, 0x0c, 0xdc, 0x00, 0x73, 0x34, 0x7a, 0xbe, 0x5b, 0xab,
-	0x81, 0x7f, 0x7c, 0x22, 0x7f, 0xc2, 0x2a, 0x51, 0xb8, 0xd2, 0xed, 0x28, 0xb2, 0xf0, 0xae, 0x75,
-	0x06, 0xd1, 0x5a, 0x14, 0xa3, 0xa2, 0x34, 0x0b, 0xea, 0x60, 0x86, 0x9a, 0xaa, 0x18, 0x07, 0x5c,
-	0xe6, 0x53, 0x2e, 0x73, 0xa9, 0xdb, 0x51, 0xce, 0x0b, 0x32, 0x2d, 0x8c, 0x83, 0x58, 0x24, 0xc9,
-	0x80, 0x55, 0x00, 0xfb, 0xaa, 0x86, 0xd7, 0x60, 0x2f, 0x26, 0x7f, 0xcc, 0xea, 0x5f, 0x50, 0xba,
-	0x1d, 0xe5, 0x72, 0x36, 0x1c, 0x1c, 0xc1, 0x54, 0x34, 0x80, 0x0b, 0xff, 0x1f, 0x8c, 0x52, 0xab,
-	0xfc, 0x33, 0x7e, 0x46, 0x4c, 0x45, 0xe5, 0xa7, 0xb6, 0xc2, 0x5c, 0xb7, 0xa3, 0x4c, 0xf5, 0x05,
-	0x55, 0xc4, 0xa0, 0xb0, 0x00, 0x16, 0xe9, 0xbf, 0x15, 0xaf, 0xbf, 0x99, 0x43, 0xe2, 0x07, 0x58,
-	0xfe, 0x79, 0x56, 0x03, 0x0d, 0x86, 0xc2, 0x35, 0x30, 0xcb, 0x03, 0x29, 0xe2, 0x80, 0xac, 0x39,
-	0xc4, 0x91, 0xbf, 0xc3, 0xbe, 0xf9, 0xc2, 0xe5, 0x6e, 0x47, 0xb9, 0xc0, 0xd7, 0x8c, 0xe2, 0xaf,
-	0xe3, 0x80, 0x68, 0x0d, 0x87, 0x38, 0x2a, 0x4a, 0x71, 0x92, 0x2a, 0xec, 0xe0, 0xf8, 0xee, 0xa9,
-	0x2a, 0x2d, 0x87, 0x1c, 0x26, 0x54, 0xd8, 0xc1, 0xa2, 0x83, 0x19, 0x6e, 0xd9, 0xc2, 0x27, 0x2c,
-	0x94, 0xef, 0x71, 0x11, 0xa1, 0x2e, 0x91, 0xc8, 0x13, 0x7c, 0x12, 0x45, 0x92, 0x64, 0x24, 0x24,
-	0x58, 0x1c, 0xdf, 0x3f, 0x4d, 0x82, 0x87, 0x91, 0x64, 0x40, 0x0b, 0x2c, 0x70, 0x83, 0x15, 0xb4,
-	0x43, 0x82, 0x1b, 0x45, 0x9d, 0xc5, 0xf2, 0x03, 0x2e, 0x74, 0xad, 0xdb, 0x51, 0xae, 0x26, 0x84,
-	0x08, 0x87, 0x69, 0x75, 0x27, 0x0a, 0x69, 0x10, 0x7d, 0x80, 0x2a, 0x0b, 0xef, 0x87, 0x2f, 0xa0,
-	0xca, 0xa3, 0x1c, 0x44, 0x87, 0xef, 0x82, 0x69, 0xba, 0x27, 0x7b, 0xb5, 0xfb, 0x3b, 0x97, 0xbb,
-	0xd8, 0xed, 0x28, 0x8b, 0x5c, 0x8e, 0xed, 0x61, 0xa1, 0x72, 0x09, 0xbc, 0xc8, 0x67, 0xe1, 0xfc,
-	0xe3, 0x14, 0x3e, 0x0f, 0x23, 0x81, 0x87, 0x6f, 0x83, 0x29, 0xfa, 0x1c, 0xd7, 0xeb, 0x9f, 0x9c,
-	0x2e, 0x77, 0x3b, 0xca, 0x39, 0x81, 0xde, 0xaf, 0x96, 0x88, 0x16, 0xc8, 0x6c, 0xed, 0x7f, 0x0d,
-	0x27, 0xf3, 0xa5, 0x45, 0x34, 0x2c, 0x83, 0x79, 0xfa, 0x98, 0xac, 0xd1, 0xbf, 0x73, 0xe9, 0xef,
-	0x8f, 0x49, 0x64, 0x2a, 0x94, 0xa5, 0x66, 0xf4, 0x58, 0x48, 0xff, 0x79, 0xae, 0x1e, 0x8f, 0x2c,
-	0x4b, 0x85, 0xef, 0xa4, 0x1a, 0xe9, 0xef, 0x47, 0xd3, 0x6f, 0x17, 0x46, 0xee, 0x38, 0xb1, 0x89,
-	0x1e, 0xfb, 0x66, 0xaa, 0x27, 0xfc, 0xe1, 0x45, 0x9b, 0x02, 0x7c, 0x1d, 0x80, 0xde, 0x49, 0x1b,
-	0xca, 0xbf, 0x1c, 0x4b, 0x9f, 0xec, 0xbd, 0xc3, 0x39, 0x54, 0x91, 0x80, 0x54, 0x7f, 0x31, 0x1d,
-	0x8f, 0x1f, 0xf4, 0x5c, 0xa6, 0x39, 0xa1, 0xe7, 0xb2, 0x94, 0x3e, 0x97, 0x69, 0x02, 0xa3, 0x73,
-	0x39, 0xc2, 0xc0, 0x57, 0xc1, 0xd9, 0x32, 0x26, 0x1f, 0xfa, 0xc1, 0x13, 0xde, 0xff, 0x0a, 0xb0,
-	0xdb, 0x51, 0x66, 0x39, 0xdc, 0xe3, 0x0e, 0x15, 0xc5, 0x10, 0x78, 0x1d, 0x8c, 0xb2, 0xae, 0xc1,
-	0x53, 0x2b, 0x9c, 0x6c, 0xbc, 0x4d, 0x30, 0x27, 0x2c, 0x82, 0xd9, 0x35, 0xdc, 0x74, 0x4e, 0x4c,
-	0x87, 0x60, 0xaf, 0x7e, 0xb2, 0x1d, 0xb2, 0x0e, 0x35, 0x23, 0x1e, 0x27, 0x0d, 0xea, 0xd7, 0x9a,
-	0x1c, 0xa0, 0x1d, 0x85, 0x2a, 0x4a, 0x51, 0xe0, 0xd7, 0x41, 0x3e, 0x69, 0x41, 0x4f, 0x59, 0xaf,
-	0x9a, 0x11, 0x7b, 0x55, 0x5a, 0x46, 0x0b, 0x9e, 0xaa, 0x28, 0xc3, 0x83, 0xef, 0x83, 0xc5, 0x9d,
-	0x56, 0xc3, 0x21, 0xb8, 0x91, 0x8a, 0x6b, 0x86, 0x09, 0x5e, 0xef, 0x76, 0x14, 0x85, 0x0b, 0xb6,
-	0x39, 0x4c, 0xcb, 0xc6, 0x37, 0x58, 0x81, 0x16, 0x0c, 0xf9, 0x6d, 0xaf, 0x61, 0xba, 0x47, 0x2e,
-	0x91, 0x17, 0x97, 0xa5, 0x95, 0xb1, 0xc2, 0xf9, 0x6e, 0x47, 0x81, 0x5c, 0x2f, 0xa0, 0x3e, 0xad,
-	0x49, 0x9d, 0x2a, 0x12, 0x90, 0xb0, 0x00, 0x66, 0x8d, 0x63, 0x97, 0x54, 0xbc, 0xa2, 0x13, 0x62,
-	0x5a, 0x48, 0xf9, 0x7c, 0xa6, 0x8b, 0x1d, 0xbb, 0x44, 0xf3, 0x3d, 0x8d, 0xd6, 0xbc, 0x1d, 0x60,
-	0x15, 0xa5, 0x18, 0xf0, 0x2d, 0x30, 0x65, 0x78, 0xce, 0x5e, 0x13, 0x57, 0x5b, 0x81, 0xbf, 0x2f,
-	0x5f, 0x60, 0x02, 0x17, 0xba, 0x1d, 0x65, 0x21, 0x12, 0x60, 0x4e, 0xad, 0x45, 0xbd, 0x2a, 0x12,
-	0xb1, 0xf0, 0x3e, 0x98, 0xa2, 0x32, 0xec, 0x65, 0xb6, 0x43, 0x59, 0x61, 0x79, 0x10, 0xb6, 0x77,
-	0x9d, 0x35, 0x70, 0x96, 0x04, 0xfa, 0xf2, 0x22, 0x98, 0x2e, 0x4b, 0x1f, 0x6b, 0x87, 0xed, 0xfd,
-	0xfd, 0x26, 0x96, 0x97, 0xd3, 0xcb, 0x32, 0x6e, 0xc8, 0xbd, 0x11, 0x35, 0xc2, 0xc2, 0x97, 0xc1,
-	0x18, 0x7d, 0x0c, 0xe5, 0x6b, 0x74, 0x82, 0x2d, 0xe4, 0xbb, 0x1d, 0x65, 0xba, 0x4f, 0x0a, 0x55,
-	0xc4, 0xdd, 0x70, 0x4b, 0x98, 0x54, 0x8a, 0xfe, 0xd1, 0x91, 0xe3, 0x35, 0x42, 0x59, 0x65, 0x9c,
-	0xab, 0xdd, 0x8e, 0x72, 0x31, 0x3d, 0xa9, 0xd4, 0x23, 0x8c, 0x38, 0xa8, 0xc4, 0x3c, 0xba, 0x1d,
-	0x51, 0xdb, 0xf3, 0x70, 0x40, 0x27, 0x27, 0xf6, 0x39, 0xdf, 0x4c, 0x77, 0xb7, 0x80, 0xf9, 0xd9,
-	0x94, 0x15, 0x77, 0xb7, 0x24, 0x05, 0x96, 0x40, 0xde, 0x38, 0x26, 0x38, 0xf0, 0x9c, 0x66, 0x4f,
-	0x66, 0x95, 0xc9, 0x08, 0x01, 0xe1, 0x08, 0x21, 0x0a, 0x65, 0x68, 0xb0, 0x08, 0x26, 0x6b, 0x24,
-	0xc0, 0x61, 0x88, 0x83, 0x50, 0xc6, 0xcb, 0xb9, 0x95, 0xa9, 0xbb, 0x73, 0xf1, 0xc9, 0x10, 0xd9,
-	0xc5, 0xf9, 0x2f, 0x8c, 0xb1, 0x2a, 0xea, 0xf3, 0xe0, 0x6d, 0x30, 0x51, 0x3c, 0xc4, 0xf5, 0x27,
-	0x54, 0x63, 0x9f, 0x25, 0x46, 0xf8, 0xcc, 0xeb, 0x91, 0x47, 0x45, 0x3d, 0x10, 0xed, 0xad, 0x9c,
-	0xbd, 0x85, 0x4f, 0xd8, 0x1c, 0xcf, 0xa6, 0xaf, 0x31, 0x71, 0xc3, 0xf1, 0x95, 0xd8, 0x99, 0x1d,
-	0xba, 0x1f, 0x61, 0x15, 0x25, 0x19, 0xf0, 0x21, 0x80, 0x09, 0x83, 0xe9, 0x04, 0x07, 0x98, 0x8f,
-	0x5f, 0x63, 0x85, 0xe5, 0x6e, 0x47, 0xb9, 0x32, 0x50, 0x47, 0x6b, 0x52, 0x9c, 0x8a, 0x06, 0x90,
-	0xe1, 0x23, 0x70, 0xae, 0x6f, 0x6d, 0xef, 0xef, 0xbb, 0xc7, 0xc8, 0xf1, 0x0e, 0xb0, 0xfc, 0x19,
-	0x17, 0x55, 0xbb, 0x1d, 0x65, 0x29, 0x2b, 0xca, 0x80, 0x5a, 0x40, 0x91, 0x2a, 0x1a, 0x28, 0x00,
-	0x1d, 0x70, 0x61, 0x90, 0xdd, 0x3a, 0xf6, 0xe4, 0xcf, 0xb9, 0xf6, 0xcb, 0xdd, 0x8e, 0xa2, 0x9e,
-	0xaa, 0xad, 0x91, 0x63, 0x4f, 0x45, 0xc3, 0x74, 0xe0, 0x26, 0x98, 0xeb, 0xb9, 0xac, 0x63, 0xaf,
-	0xd2, 0x0a, 0xe5, 0x2f, 0xb8, 0xb4, 0xb0, 0x25, 0x04, 0x69, 0x72, 0xec, 0x69, 0x7e, 0x2b, 0x54,
-	0x51, 0x9a, 0x06, 0xdf, 0x8b, 0x6b, 0xc3, 0xa7, 0x84, 0x90, 0x8f, 0xa2, 0x63, 0x62, 0x27, 0x8f,
-	0x74, 0xf8, 0x7c, 0x11, 0xf6, 0x4a, 0x13, 0x11, 0xe0, 0x6b, 0xf1, 0x9e, 0x7a, 0x58, 0xad, 0xf1,
-	0x21, 0x74, 0x4c, 0x6c, 0x1b, 0x11, 0xfb, 0x83, 0x56, 0x7f, 0x13, 0x3d, 0xac, 0xd6, 0xd4, 0x6f,
-	0x80, 0x89, 0x78, 0x47, 0xd1, 0x93, 0xdd, 0x3a, 0x69, 0x45, 0x37, 0x50, 0xf1, 0x64, 0x27, 0x27,
-	0x2d, 0xac, 0x22, 0xe6, 0x84, 0x37, 0xc1, 0xf8, 0x23, 0xec, 0x1e, 0x1c, 0x12, 0xd6, 0x2b, 0xa4,
-	0xc2, 0x7c, 0xb7, 0xa3, 0xcc, 0x70, 0xd8, 0x87, 0xcc, 0xae, 0xa2, 0x08, 0xa0, 0x7e, 0x6b, 0x8e,
-	0x8f, 0xc4, 0x54, 0xb8, 0x7f, 0xb5, 0x15, 0x85, 0x3d, 0xe7, 0x88, 0x0a, 0xb3, 0x5b, 0xae, 0xd0,
-	0xb4, 0x46, 0x5e, 0xa0, 0x69, 0xad, 0x82, 0xf1, 0x47, 0xba, 0x49, 0xd1, 0xb9, 0x74, 0xcf, 0xfa,
-	0xd0, 0x69, 0x72, 0x70, 0x84, 0x80, 0x15, 0xb0, 0xb0, 0x89, 0x9d, 0x80, 0xec, 0x61, 0x87, 0x94,
-	0x3c, 0x82, 0x83, 0xa7, 0x4e, 0x33, 0x6a, 0x49, 0x39, 0xb1, 0x52, 0x87, 0x31, 0x48, 0x73, 0x23,
-	0x94, 0x8a, 0x06, 0x31, 0x61, 0x09, 0xcc, 0x1b, 0x4d, 0x5c, 0x27, 0xae, 0xef, 0x59, 0xee, 0x11,
-	0xf6, 0xdb, 0x64, 0x3b, 0x64, 0xad, 0x29, 0x27, 0x1e, 0x29, 0x38, 0x82, 0x68, 0x84, 0x63, 0x54,
-	0x94, 0x65, 0xd1, 0x53, 0xc5, 0x74, 0x43, 0x82, 0x3d, 0xe1, 0x72, 0xbf, 0x98, 0x3e, 0xe6, 0x9a,
-	0x0c, 0x11, 0xdf, 0x43, 0xda, 0x41, 0x33, 0x54, 0x51, 0x86, 0x06, 0x11, 0x58, 0xd0, 0x1b, 0x4f,
-	0x71, 0x40, 0xdc, 0x10, 0x0b, 0x6a, 0xe7, 0x99, 0x9a, 0xf0, 0x71, 0x3a, 0x31, 0x28, 0x29, 0x38,
-	0x88, 0x0c, 0xdf, 0x8a, 0xe7, 0x71, 0xbd, 0x4d, 0x7c, 0xcb, 0xac, 0x45, 0x2d, 0x46, 0xa8, 0x8d,
-	0xd3, 0x26, 0xbe, 0x46, 0xa8, 0x40, 0x12, 0x49, 0x0f, 0xdd, 0xfe, 0xfd, 0x40, 0x6f, 0x93, 0x43,
-	0x59, 0x66, 0xdc, 0x21, 0x57, 0x0a, 0xa7, 0x9d, 0xba, 0x52, 0x50, 0x0a, 0xfc, 0x9a, 0x28, 0xb2,
-	0xee, 0x36, 0xb1, 0x7c, 0x31, 0x7d, 0x3b, 0x66, 0xec, 0x7d, 0x97, 0x76, 0x9a, 0x14, 0xb6, 0x1f,
-	0xfd, 0x16, 0x3e, 0x61, 0xe4, 0x4b, 0xe9, 0x9d, 0x45, 0xbf, 0x4a, 0xce, 0x4d, 0x22, 0xa1, 0x99,
-	0x99, 0xf7, 0x99, 0xc0, 0xe5, 0xf4, 0x6d, 0x44, 0x98, 0x25, 0xb9, 0xce, 0x20, 0x1a, 0xcd, 0x05,
-	0x2f, 0x17, 0x1d, 0x34, 0x59, 0x55, 0x14, 0x56, 0x15, 0x21, 0x17, 0x51, 0x8d, 0xd9, 0x80, 0xca,
-	0x0b, 0x92, 0xa2, 0x40, 0x0b, 0xcc, 0xf7, 0x4a, 0xd4, 0xd3, 0x59, 0x66, 0x3a, 0xc2, 0x49, 0xe6,
-	0x7a, 0x2e, 0x71, 0x9d, 0xa6, 0xd6, 0xaf, 0xb2, 0x20, 0x99, 0x15, 0xa0, 0x73, 0x00, 0xfd, 0x3b,
-	0xae, 0xef, 0x35, 0x56, 0xa3, 0xf4, 0x10, 0xdf, 0x2f, 0xb2, 0x08, 0xa6, 0xb7, 0x68, 0x76, 0x9d,
-	0x48, 0x96, 0x59, 0x65, 0x12, 0xc2, 0x86, 0xe3, 0x77, 0x90, 0x4c, 0xad, 0x07, 0x70, 0xe9, 0xd8,
-	0x1d, 0x5f, 0x50, 0x58, 0xbe, 0xaf, 0x0f, 0xbf, 0xcf, 0xf0, 0x74, 0x27, 0xe0, 0xf1, 0xcb, 0xc4,
-	0xe5, 0x7e, 0x69, 0xe8, 0x8d, 0x84, 0x93, 0x45, 0x30, 0xdc, 0x4e, 0xdd, 0x20, 0x98, 0xc2, 0x8d,
-	0xe7, 0x5d, 0x20, 0xb8, 0x50, 0x96, 0x49, 0xc7, 0xbb, 0x12, 0x2f, 0x45, 0xb1, 0xd9, 0x66, 0xff,
-	0x2b, 0x78, 0x33, 0xbd, 0x77, 0xe2, 0x52, 0xd5, 0x39, 0x40, 0x45, 0x29, 0x06, 0xfd, 0xa2, 0x93,
-	0x96, 0x1a, 0x71, 0x08, 0x8e, 0xa6, 0x0e, 0x21, 0xc1, 0x29, 0x21, 0x2d, 0xa4, 0x30, 0x15, 0x0d,
-	0x22, 0x67, 0x35, 0x2d, 0xff, 0x09, 0xf6, 0xe4, 0x57, 0x9e, 0xa7, 0x49, 0x28, 0x2c, 0xa3, 0xc9,
-	0xc8, 0xf0, 0x01, 0x98, 0x89, 0xef, 0x30, 0x45, 0xbf, 0xed, 0x11, 0xf9, 0x1e, 0x3b, 0x0b, 0xc5,
-	0xe6, 0x15, 0x5f, 0x96, 0xea, 0xd4, 0x4f, 0x9b, 0x97, 0x88, 0x87, 0x26, 0x98, 0x7f, 0xd8, 0xf6,
-	0x89, 0x53, 0x70, 0xea, 0x4f, 0xb0, 0xd7, 0x28, 0x9c, 0x10, 0x1c, 0xca, 0xaf, 0x31, 0x11, 0x61,
-	0xd6, 0xff, 0x80, 0x42, 0xb4, 0x3d, 0x8e, 0xd1, 0xf6, 0x28, 0x48, 0x45, 0x59, 0x22, 0x6d, 0x25,
-	0xd5, 0x00, 0xef, 0xfa, 0x04, 0xcb, 0x0f, 0xd2, 0xc7, 0x55, 0x2b, 0xc0, 0xda, 0x53, 0x9f, 0x66,
-	0x27, 0xc6, 0x88, 0x19, 0xf1, 0x83, 0xa0, 0xdd, 0x22, 0x6c, 0x62, 0x92, 0xdf, 0x4b, 0x6f, 0xe3,
-	0x5e, 0x46, 0x38, 0x4a, 0x63, 0x33, 0x96, 0x90, 0x11, 0x81, 0x4c, 0xdb, 0xa4, 0xe9, 0x1f, 0x1c,
-	0xe0, 0x40, 0xde, 0x60, 0x89, 0x15, 0xda, 0x64, 0x93, 0xd9, 0x55, 0x14, 0x01, 0xe8, 0xfd, 0xc1,
-	0xf4, 0x0f, 0x2a, 0x6d, 0xd2, 0x6a, 0x93, 0x50, 0xde, 0x64, 0xdf, 0xb3, 0x70, 0x7f, 0x68, 0xfa,
-	0x07, 0x9a, 0xcf, 0x9d, 0x2a, 0x12, 0x90, 0xf0, 0x0e, 0x98, 0x30, 0xfd, 0x03, 0x13, 0x3f, 0xc5,
-	0x4d, 0xb9, 0x94, 0x3e, 0x14, 0x29, 0xab, 0x49, 0x5d, 0x2a, 0xea, 0xa1, 0x56, 0xff, 0x2b, 0x81,
-	0xe9, 0xb8, 0xdb, 0xb3, 0x66, 0x0e, 0xc1, 0xec, 0xd6, 0xae, 0xfd, 0x08, 0x95, 0x2c, 0xc3, 0xae,
-	0x6d, 0xeb, 0xa6, 0x99, 0x3f, 0x93, 0xb0, 0x99, 0x3a, 0xda, 0x30, 0xf2, 0x12, 0x5c, 0x00, 0x73,
-	0x5b, 0xbb, 0x36, 0x32, 0xf4, 0x35, 0xbb, 0x52, 0x36, 0xec, 0x2d, 0xe3, 0xfd, 0xfc, 0x08, 0x9c,
-	0x07, 0x33, 0xb1, 0x11, 0xe9, 0xe5, 0x0d, 0x23, 0x9f, 0x83, 0x8b, 0x60, 0x7e, 0x6b, 0xd7, 0x5e,
-	0x33, 0x4c, 0xc3, 0x32, 0x7a, 0xc8, 0xd1, 0x88, 0x1e, 0x99, 0x39, 0x76, 0x0c, 0x5e, 0x00, 0x0b,
-	0x5b, 0xbb, 0xb6, 0xf5, 0xb8, 0x1c, 0xad, 0xc5, 0xdd, 0xf9, 0x71, 0x38, 0x09, 0xc6, 0x4c, 0x43,
-	0xaf, 0x19, 0x79, 0x40, 0x89, 0x86, 0x69, 0x14, 0xad, 0x52, 0xa5, 0x6c, 0xa3, 0x9d, 0x72, 0xd9,
-	0x40, 0xf9, 0x73, 0x30, 0x0f, 0xa6, 0x1f, 0xe9, 0x56, 0x71, 0x33, 0xb6, 0x28, 0x74, 0x59, 0xb3,
-	0x52, 0xdc, 0xb2, 0x91, 0x5e, 0x34, 0x50, 0x6c, 0xbe, 0x49, 0x81, 0x4c, 0x28, 0xb6, 0xdc, 0x5b,
-	0x2d, 0x80, 0xb3, 0xd1, 0x34, 0x0c, 0xa7, 0xc0, 0xd9, 0xad, 0x5d, 0x7b, 0x53, 0xaf, 0x6d, 0xe6,
-	0xcf, 0xf4, 0x91, 0xc6, 0xe3, 0x6a, 0x09, 0xd1, 0x37, 0x06, 0x60, 0x3c, 0x62, 0x8d, 0xc0, 0x69,
-	0x30, 0x51, 0xae, 0xd8, 0xc5, 0x4d, 0xa3, 0xb8, 0x95, 0xcf, 0xad, 0xfe, 0x38, 0x27, 0xfc, 0x7a,
-	0x00, 0xe7, 0xc0, 0x54, 0xb9, 0x62, 0xd9, 0x35, 0x4b, 0x47, 0x96, 0xb1, 0x96, 0x3f, 0x03, 0xcf,
-	0x03, 0x58, 0x2a, 0x97, 0xac, 0x92, 0x6e, 0x72, 0xa3, 0x6d, 0x58, 0xc5, 0xb5, 0x3c, 0xa0, 0x4b,
-	0x20, 0x43, 0xb0, 0x4c, 0x51, 0x4b, 0xad, 0xb4, 0x61, 0x19, 0x68, 0x9b, 0x5b, 0xce, 0xc1, 0x65,
-	0x70, 0xa5, 0x56, 0xda, 0x78, 0xb8, 0x53, 0xe2, 0x18, 0x5b, 0x2f, 0xaf, 0xd9, 0xc8, 0xd8, 0xae,
-	0xec, 0x1a, 0xf6, 0x9a, 0x6e, 0xe9, 0xf9, 0x45, 0x9a, 0xf3, 0x9a, 0xbe, 0x6b, 0xd8, 0xb5, 0xb2,
-	0x5e, 0xad, 0x6d, 0x56, 0xac, 0xfc, 0x12, 0xbc, 0x06, 0xae, 0x52, 0xe1, 0x0a, 0x32, 0xec, 0x78,
-	0x81, 0x75, 0x54, 0xd9, 0xee, 0x43, 0x14, 0x78, 0x11, 0x2c, 0x0e, 0x76, 0x2d, 0x53, 0x76, 0x66,
-	0x49, 0x1d, 0x15, 0x37, 0x4b, 0xf1, 0x9a, 0x2b, 0xf0, 0x36, 0x78, 0xe5, 0xb4, 0xa8, 0xd8, 0x73,
-	0xcd, 0xaa, 0x54, 0x6d, 0x7d, 0xc3, 0x28, 0x5b, 0xf9, 0x9b, 0xf0, 0x2a, 0xb8, 0x58, 0x30, 0xf5,
-	0xe2, 0xd6, 0x66, 0xc5, 0x34, 0xec, 0xaa, 0x61, 0x20, 0xbb, 0x5a, 0x41, 0x96, 0x6d, 0x3d, 0xb6,
-	0xd1, 0xe3, 0x7c, 0x03, 0x2a, 0xe0, 0xf2, 0x4e, 0x79, 0x38, 0x00, 0xc3, 0x4b, 0x60, 0x71, 0xcd,
-	0x30, 0xf5, 0xf7, 0x33, 0xae, 0x67, 0x12, 0xbc, 0x02, 0x2e, 0xec, 0x94, 0x07, 0x7b, 0x3f, 0x91,
-	0x56, 0xff, 0x0a, 0xc0, 0x28, 0xbd, 0x3e, 0x42, 0x19, 0x9c, 0x8b, 0x73, 0x4b, 0xb7, 0xe1, 0x7a,
-	0xc5, 0x34, 0x2b, 0x8f, 0x0c, 0x94, 0x3f, 0x13, 0xbd, 0x4d, 0xc6, 0x63, 0xef, 0x94, 0xad, 0x92,
-	0x69, 0x5b, 0xa8, 0xb4, 0xb1, 0x61, 0xa0, 0x7e, 0x86, 0x24, 0xfa, 0x3d, 0xc4, 0x04, 0xd3, 0xd0,
-	0xd7, 0xd8, 0x8e, 0xb8, 0x09, 0x6e, 0x24, 0x6d, 0xc3, 0xe8, 0x39, 0x91, 0xfe, 0x70, 0xa7, 0x82,
-	0x76, 0xb6, 0xf3, 0xa3, 0x74, 0xd3, 0xc4, 0x36, 0xfa, 0xcd, 0x8d, 0xc1, 0xeb, 0x40, 0x89, 0x53,
-	0x2c, 0x64, 0x37, 0x11, 0x39, 0x80, 0xf7, 0xc1, 0xeb, 0xcf, 0x01, 0x0d, 0x8b, 0x62, 0x8a, 0x96,
-	0x64, 0x00, 0x37, 0x7a, 0x9f, 0x69, 0xf8, 0x1a, 0xb8, 0x33, 0xd4, 0x3d, 0x4c, 0x74, 0x06, 0xae,
-	0x83, 0xc2, 0x00, 0x16, 0x7f, 0xcb, 0xc8, 0xc2, 0xf7, 0x65, 0x24, 0x14, 0x53, 0xa3, 0x4d, 0x58,
-	0x44, 0xf4, 0x2b, 0xce, 0xcf, 0xc2, 0x55, 0xf0, 0xf2, 0xd0, 0xed, 0x90, 0x4c, 0x42, 0x03, 0xea,
-	0xe0, 0x9d, 0x17, 0xc3, 0x0e, 0x0b, 0x1b, 0xc3, 0x97, 0xc0, 0xf2, 0x70, 0x89, 0x28, 0x25, 0xfb,
-	0xf0, 0x6d, 0xf0, 0xc6, 0xf3, 0x50, 0xc3, 0x96, 0x38, 0x38, 0x7d, 0x89, 0x68, 0x1b, 0x1c, 0xd2,
-	0x6f, 0x6f, 0x38, 0x8a, 0x6e, 0x0c, 0x17, 0xfe, 0x1f, 0x50, 0x07, 0x6e, 0xf6, 0x64, 0x5a, 0x9e,
-	0x49, 0xf0, 0x16, 0xb8, 0x89, 0xf4, 0xf2, 0x5a, 0x65, 0xdb, 0x7e, 0x01, 0xfc, 0x27, 0x12, 0x7c,
-	0x17, 0xbc, 0xf5, 0x7c, 0xe0, 0xb0, 0x17, 0xfc, 0x54, 0x82, 0x06, 0x78, 0xef, 0x85, 0xd7, 0x1b,
-	0x26, 0xf3, 0x99, 0x04, 0xaf, 0x81, 0x2b, 0x83, 0xf9, 0x51, 0x1d, 0x3e, 0x97, 0xe0, 0x0a, 0xb8,
-	0x7e, 0xea, 0x4a, 0x11, 0xf2, 0x0b, 0x09, 0xbe, 0x09, 0xee, 0x9d, 0x06, 0x19, 0x16, 0xc6, 0xaf,
-	0x24, 0xf8, 0x00, 0xdc, 0x7f, 0x81, 0x35, 0x86, 0x09, 0xfc, 0xfa, 0x94, 0xf7, 0x88, 0x8a, 0xfd,
-	0xe5, 0xf3, 0xdf, 0x23, 0x42, 0xfe, 0x46, 0x82, 0x4b, 0xe0, 0xe2, 0x60, 0x08, 0xdd, 0x13, 0xbf,
-	0x95, 0xe0, 0x0d, 0xb0, 0x7c, 0xaa, 0x12, 0x85, 0xfd, 0x4e, 0x82, 0x32, 0x58, 0x28, 0x57, 0xec,
-	0x75, 0xbd, 0x64, 0xda, 0x8f, 0x4a, 0xd6, 0xa6, 0x5d, 0xb3, 0x90, 0x51, 0xab, 0xe5, 0x7f, 0x3a,
-	0x42, 0x43, 0x49, 0x78, 0xca, 0x95, 0xc8, 0x69, 0xaf, 0x57, 0x90, 0x6d, 0x96, 0x76, 0x8d, 0x32,
-	0x45, 0x7e, 0x3c, 0x02, 0xe7, 0x00, 0xa0, 0xb0, 0x6a, 0xa5, 0x54, 0xb6, 0x6a, 0xf9, 0x6f, 0xe7,
-	0xe0, 0x0c, 0x98, 0x30, 0x1e, 0x5b, 0x06, 0x2a, 0xeb, 0x66, 0xfe, 0x6f, 0xb9, 0xbb, 0x0f, 0xc0,
-	0xa4, 0x15, 0x38, 0x5e, 0xd8, 0xf2, 0x03, 0x02, 0xef, 0x8a, 0x0f, 0xb3, 0xd1, 0xff, 0x67, 0x45,
-	0xbf, 0xb9, 0x5f, 0x9a, 0xeb, 0x3d, 0xf3, 0x9f, 0x63, 0xd5, 0x33, 0x2b, 0xd2, 0x1d, 0xa9, 0x70,
-	0xee, 0xd9, 0x9f, 0x96, 0xce, 0x3c, 0xfb, 0x6a, 0x49, 0xfa, 0xf2, 0xab, 0x25, 0xe9, 0x8f, 0x5f,
-	0x2d, 0x49, 0x3f, 0xfa, 0xf3, 0xd2, 0x99, 0xbd, 0x71, 0xf6, 0x9b, 0xfd, 0xbd, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x44, 0x6a, 0x6d, 0x90, 0xfc, 0x1f, 0x00, 0x00,
+	// 3270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x5a, 0x4b, 0x73, 0xdb, 0xc8,
+	0xb5, 0x36, 0x45, 0x49, 0x96, 0x5a, 0x2f, 0xaa, 0x65, 0xd9, 0x90, 0x1f, 0x02, 0x0d, 0x3f, 0x46,
+	0xd6, 0x0c, 0xec, 0xb9, 0xf6, 0xd4, 0x3c, 0x3c, 0x0f, 0x0f, 0x48, 0xc1, 0x12, 0xaf, 0x20, 0x52,
+	0x6e, 0x42, 0xb2, 0x67, 0x36, 0x28, 0x88, 0x6c, 0x49, 0xb8, 0xa2, 0x00, 0x0e, 0xd0, 0x94, 0xa5,
+	0xf9, 0x03, 0x77, 0x77, 0xeb, 0xe6, 0x59, 0x49, 0x55, 0x7e, 0x42, 0x66, 0xe6, 0x17, 0x64, 0x95,
+	0x8d, 0xe7, 0x95, 0x4c, 0x92, 0x55, 0xb2, 0x60, 0x25, 0x93, 0x4d, 0x56, 0x59, 0xb0, 0xf2, 0x5e,
+	0xa4, 0x52, 0xdd, 0x0d, 0x90, 0x0d, 0x80, 0x94, 0xbd, 0xb2, 0xfa, 0x9c, 0xef, 0xfb, 0x70, 0xba,
+	0x4f, 0xa3, 0xfb, 0x1c, 0x98, 0x60, 0xc6, 0x6f, 0xd6, 0x9a, 0x3b, 0x77, 0xfc, 0x66, 0xed, 0x76,
+	0xd3, 0xf7, 0x88, 0x07, 0x47, 0x98, 0xe1, 0xa2, 0xba, 0xe7, 0x90, 0xfd, 0xd6, 0xce, 0xed, 0x9a,
+	0x77, 0x78, 0x67, 0xcf, 0xdb, 0xf3, 0xee, 0x30, 0xef, 0x4e, 0x6b, 0x97, 0x8d, 0xd8, 0x80, 0xfd,
+	0xc5, 0x59, 0xca, 0xff, 0x66, 0xc0, 0x59, 0x84, 0x3f, 0x6a, 0xe1, 0x80, 0xc0, 0xdb, 0x60, 0xbc,
+	0xd2, 0xc4, 0xbe, 0x4d, 0x1c, 0xcf, 0x95, 0x32, 0xf9, 0xcc, 0xd2, 0xf4, 0xdd, 0xdc, 0x6d, 0xa6,
+	0x7a, 0xbb, 0x6b, 0x47, 0x3d, 0x08, 0xbc, 0x01, 0x46, 0x37, 0xf0, 0xe1, 0x0e, 0xf6, 0xa5, 0xa1,
+	0x7c, 0x66, 0x69, 0xe2, 0xee, 0x54, 0x08, 0xe6, 0x46, 0x14, 0x3a, 0x29, 0xcc, 0xc4, 0x01, 0xc1,
+	0xbe, 0x94, 0x8d, 0xc1, 0xb8, 0x11, 0x85, 0x4e, 0xe5, 0x4f, 0x43, 0x60, 0xb2, 0xea, 0xda, 0xcd,
+	0x60, 0xdf, 0x23, 0x25, 0x77, 0xd7, 0x83, 0x8b, 0x00, 0x70, 0x85, 0xb2, 0x7d, 0x88, 0x59, 0x3c,
+	0xe3, 0x48, 0xb0, 0xc0, 0x65, 0x90, 0xe3, 0xa3, 0x62, 0xc3, 0xc1, 0x2e, 0xd9, 0x42, 0x46, 0x20,
+	0x0d, 0xe5, 0xb3, 0x4b, 0xe3, 0x28, 0x65, 0x87, 0x4a, 0x4f, 0x7b, 0xd3, 0x26, 0xfb, 0x2c, 0x92,
+	0x71, 0x14, 0xb3, 0x51, 0xbd, 0x68, 0xfc, 0xd0, 0x69, 0xe0, 0xaa, 0xf3, 0x31, 0x96, 0x86, 0x19,
+	0x2e, 0x65, 0x87, 0xaf, 0x80, 0xd9, 0xc8, 0x66, 0x7a, 0xc4, 0x6e, 0x30, 0xf0, 0x08, 0x03, 0xa7,
+	0x1d, 0xa2, 0x32, 0x33, 0xae, 0xe3, 0x13, 0x69, 0x34, 0x9f, 0x59, 0xca, 0xa2, 0x94, 0x5d, 0x8c,
+	0x74, 0xcd, 0x0e, 0xf6, 0xa5, 0xb3, 0x0c, 0x17, 0xb3, 0x89, 0x7a, 0x08, 0x1f, 0x39, 0x01, 0xcd,
+	0xd7, 0x58, 0x5c, 0x2f, 0xb2, 0x43, 0x08, 0x86, 0x4d, 0xcf, 0x3b, 0x90, 0xc6, 0x59, 0x70, 0xec,
+	0x6f, 0xe5, 0x27, 0x19, 0x30, 0x86, 0x70, 0xd0, 0xf4, 0xdc, 0x00, 0x43, 0x09, 0x9c, 0xad, 0xb6,
+	0x6a, 0x35, 0x1c, 0x04, 0x6c, 0x8d, 0xc7, 0x50, 0x34, 0x84, 0xe7, 0xc1, 0x68, 0x95, 0xd8, 0xa4,
+	0x15, 0xb0, 0xfc, 0x8e, 0xa3, 0x70, 0x24, 0xe4, 0x3d, 0x7b, 0x5a, 0xde, 0xdf, 0x88, 0xe7, 0x93,
+	0xad, 0xe5, 0xc4, 0xdd, 0xb9, 0x10, 0x2c, 0xba, 0x50, 0x0c, 0xa8, 0x7c, 0x31, 0x19, 0x3d, 0x00,
+	0xbe, 0x0a, 0xc6, 0x74, 0x52, 0xab, 0xeb, 0xc7, 0xb8, 0xc6, 0x77, 0x40, 0xe1, 0x5c, 0xa7, 0x2d,
+	0xe7, 0x4e, 0xec, 0xc3, 0xc6, 0x7d, 0x05, 0x93, 0x5a, 0x5d, 0xc5, 0xc7, 0xb8, 0xa6, 0xa0, 0x2e,
+	0x0a, 0xde, 0x03, 0xe3, 0xda, 0x1e, 0x76, 0x89, 0x56, 0xaf, 0xfb, 0xd2, 0x04, 0xa3, 0xcc, 0x77,
+	0xda, 0xf2, 0x2c, 0xa7, 0xd8, 0xd4, 0xa5, 0xda, 0xf5, 0xba, 0xaf, 0xa0, 0x1e, 0x0e, 0x1a, 0x60,
+	0xf6, 0xa1, 0xed, 0x34, 0x9a, 0x9e, 0xe3, 0x92, 0x35, 0xd3, 0xdc, 0x64, 0xe4, 0x49, 0x46, 0x5e,
+	0xec, 0xb4, 0xe5, 0x8b, 0x9c, 0xbc, 0x1b, 0x41, 0xd4, 0x7d, 0x42, 0x9a, 0xa1, 0x4a, 0x9a, 0x08,
+	0x55, 0x70, 0xb6, 0x60, 0x07, 0x78, 0xc5, 0xf1, 0x25, 0xcc, 0x34, 0xe6, 0x3a, 0x6d, 0x79, 0x86,
+	0x6b, 0xec, 0xd8, 0x01, 0x56, 0xeb, 0x8e, 0xaf, 0xa0, 0x08, 0x03, 0x57, 0xc1, 0x0c, 0x8d, 0x9e,
+	0xef, 0xd6, 0x4d, 0xdf, 0x3b, 0x3e, 0x91, 0x3e, 0x67, 0x99, 0x28, 0x5c, 0xee, 0xb4, 0x65, 0x49,
+	0x98, 0x6b, 0x8d, 0x41, 0xd4, 0x26, 0xc5, 0x28, 0x28, 0xc9, 0x82, 0x1a, 0x98, 0xa2, 0xa6, 0x4d,
+	0x8c, 0x7d, 0x2e, 0xf3, 0x05, 0x97, 0xb9, 0xd8, 0x69, 0xcb, 0xe7, 0x05, 0x99, 0x26, 0xc6, 0x7e,
+	0x24, 0x12, 0x67, 0xc0, 0x4d, 0x00, 0x7b, 0xaa, 0xba, 0x5b, 0x67, 0x13, 0x93, 0x3e, 0x61, 0xf9,
+	0x2f, 0xc8, 0x9d, 0xb6, 0x7c, 0x29, 0x1d, 0x0e, 0x0e, 0x61, 0x0a, 0xea, 0xc3, 0x85, 0xff, 0x05,
+	0x86, 0xa9, 0x55, 0xfa, 0x94, 0x9f, 0x11, 0x13, 0x61, 0xfa, 0xa9, 0xad, 0x30, 0xd3, 0x69, 0xcb,
+	0x13, 0x3d, 0x41, 0x05, 0x31, 0x28, 0x2c, 0x80, 0x79, 0xfa, 0x6f, 0xc5, 0xed, 0x6d, 0xe6, 0x80,
+	0x78, 0x3e, 0x96, 0x3e, 0x4b, 0x6b, 0xa0, 0xfe, 0x50, 0xb8, 0x02, 0xa6, 0x79, 0x20, 0x45, 0xec,
+	0x93, 0x15, 0x9b, 0xd8, 0xd2, 0x77, 0xd8, 0x3b, 0x5f, 0xb8, 0xd4, 0x69, 0xcb, 0x17, 0xf8, 0x33,
+	0xc3, 0xf8, 0x6b, 0xd8, 0x27, 0x6a, 0xdd, 0x26, 0xb6, 0x82, 0x12, 0x9c, 0xb8, 0x0a, 0x3b, 0x38,
+	0xbe, 0x7b, 0xaa, 0x4a, 0xd3, 0x26, 0xfb, 0x0a, 0x4a, 0x70, 0x68, 0x5e, 0xb8, 0x65, 0x1d, 0x9f,
+	0xb0, 0x50, 0xbe, 0xc7, 0x45, 0x84, 0xbc, 0x84, 0x22, 0x07, 0xf8, 0x24, 0x8c, 0x24, 0xce, 0x88,
+	0x49, 0xb0, 0x38, 0xbe, 0x7f, 0x9a, 0x04, 0x0f, 0x23, 0xce, 0x80, 0x26, 0x98, 0xe3, 0x06, 0xd3,
+	0x6f, 0x05, 0x04, 0xd7, 0x8b, 0x1a, 0x8b, 0xe5, 0x07, 0x5c, 0xe8, 0x6a, 0xa7, 0x2d, 0x5f, 0x89,
+	0x09, 0x11, 0x0e, 0x53, 0x6b, 0x76, 0x18, 0x52, 0x3f, 0x7a, 0x1f, 0x55, 0x16, 0xde, 0x0f, 0x5f,
+	0x40, 0x95, 0x47, 0xd9, 0x8f, 0x0e, 0xdf, 0x03, 0x93, 0x74, 0x4f, 0x76, 0x73, 0xf7, 0x57, 0x2e,
+	0xb7, 0xd0, 0x69, 0xcb, 0xf3, 0x5c, 0x8e, 0xed, 0x61, 0x21, 0x73, 0x31, 0xbc, 0xc8, 0x67, 0xe1,
+	0xfc, 0xed, 0x14, 0x3e, 0x0f, 0x23, 0x86, 0x87, 0x6f, 0x83, 0x09, 0x3a, 0x8e, 0xf2, 0xf5, 0x77,
+	0x4e, 0x97, 0x3a, 0x6d, 0xf9, 0x9c, 0x40, 0xef, 0x65, 0x4b, 0x44, 0x0b, 0x64, 0xf6, 0xec, 0x7f,
+	0x0c, 0x26, 0xf3, 0x47, 0x8b, 0x68, 0x58, 0x06, 0xb3, 0x74, 0x18, 0xcf, 0xd1, 0x3f, 0xb3, 0xc9,
+	0xf7, 0x8f, 0x49, 0xa4, 0x32, 0x94, 0xa6, 0xa6, 0xf4, 0x58, 0x48, 0xff, 0x7a, 0xae, 0x1e, 0x8f,
+	0x2c, 0x4d, 0x85, 0xef, 0x26, 0x2e, 0xd2, 0xdf, 0x0e, 0x27, 0x67, 0x17, 0x84, 0xee, 0x68, 0x61,
+	0x63, 0x77, 0xec, 0x9b, 0x89, 0x3b, 0xe1, 0x77, 0x2f, 0x7a, 0x29, 0xc0, 0xd7, 0x01, 0xe8, 0x9e,
+	0xb4, 0x81, 0xf4, 0xb3, 0x91, 0xe4, 0xc9, 0xde, 0x3d, 0x9c, 0x03, 0x05, 0x09, 0x48, 0xe5, 0xe7,
+	0x30, 0x2a, 0x3f, 0xe8, 0xb9, 0x4c, 0xd7, 0x84, 0x9e, 0xcb, 0x99, 0xe4, 0xb9, 0x4c, 0x17, 0x30,
+	0x3c, 0x97, 0x43, 0x0c, 0x7c, 0x05, 0x9c, 0x2d, 0x63, 0xf2, 0xd4, 0xf3, 0x0f, 0xf8, 0xfd, 0x57,
+	0x80, 0x9d, 0xb6, 0x3c, 0xcd, 0xe1, 0x2e, 0x77, 0x28, 0x28, 0x82, 0xc0, 0x6b, 0x60, 0x98, 0xdd,
+	0x1a, 0x7c, 0x69, 0x85, 0x93, 0x8d, 0x5f, 0x13, 0xcc, 0x49, 0x2f, 0x27, 0x84, 0x9b, 0x9e, 0x4f,
+	0x68, 0x0c, 0xc3, 0xc9, 0x29, 0xf8, 0xcc, 0xc5, 0xa3, 0xe8, 0xe1, 0x60, 0x11, 0x4c, 0xaf, 0xe0,
+	0x86, 0x7d, 0x62, 0xd8, 0x04, 0xbb, 0xb5, 0x93, 0x8d, 0x80, 0x5d, 0x6b, 0x53, 0xe2, 0x19, 0x54,
+	0xa7, 0x7e, 0xb5, 0xc1, 0x01, 0xea, 0x61, 0xa0, 0xa0, 0x04, 0x05, 0xfe, 0x37, 0xc8, 0xc5, 0x2d,
+	0xe8, 0x88, 0x5d, 0x70, 0x53, 0xe2, 0x05, 0x97, 0x94, 0x51, 0xfd, 0x23, 0x05, 0xa5, 0x78, 0xf0,
+	0x03, 0x30, 0xbf, 0xd5, 0xac, 0xdb, 0x04, 0xd7, 0x13, 0x71, 0x4d, 0x31, 0xc1, 0x6b, 0x9d, 0xb6,
+	0x2c, 0x73, 0xc1, 0x16, 0x87, 0xa9, 0xe9, 0xf8, 0xfa, 0x2b, 0xd0, 0x2c, 0x23, 0xaf, 0xe5, 0xd6,
+	0x0d, 0xe7, 0xd0, 0x21, 0xd2, 0x7c, 0x3e, 0xb3, 0x34, 0x52, 0x38, 0xdf, 0x69, 0xcb, 0x30, 0x5c,
+	0x21, 0xea, 0x53, 0x1b, 0xd4, 0xa9, 0x20, 0x01, 0x09, 0x0b, 0x60, 0x5a, 0x3f, 0x76, 0x48, 0xc5,
+	0x2d, 0xda, 0x01, 0xa6, 0xd9, 0x97, 0xce, 0xa7, 0xae, 0xbe, 0x63, 0x87, 0xa8, 0x9e, 0xab, 0xd2,
+	0x8d, 0xd2, 0xf2, 0xb1, 0x82, 0x12, 0x0c, 0xf8, 0x16, 0x98, 0xd0, 0x5d, 0x7b, 0xa7, 0x81, 0x37,
+	0x9b, 0xbe, 0xb7, 0x2b, 0x5d, 0x60, 0x02, 0x17, 0x3a, 0x6d, 0x79, 0x2e, 0x14, 0x60, 0x4e, 0xb5,
+	0x49, 0xbd, 0x0a, 0x12, 0xb1, 0x10, 0x83, 0x05, 0x64, 0xbb, 0x75, 0xef, 0xb0, 0xe4, 0x3a, 0xc4,
+	0xb1, 0x1b, 0x45, 0xcf, 0xf7, 0x5b, 0x4d, 0x52, 0xdc, 0xc7, 0xb5, 0x03, 0x49, 0x62, 0x42, 0x2f,
+	0x75, 0xda, 0xf2, 0xb5, 0x70, 0x16, 0x0c, 0xaa, 0x3a, 0x1c, 0xab, 0xd6, 0x38, 0x58, 0xad, 0x51,
+	0xb4, 0x82, 0x06, 0x2b, 0xd1, 0x3d, 0x56, 0xc5, 0xb8, 0x2e, 0x2d, 0xd0, 0x5a, 0x4f, 0xdc, 0x63,
+	0x01, 0xc6, 0xf4, 0xf6, 0xa4, 0x4e, 0x78, 0x1f, 0x4c, 0xd0, 0x29, 0xb1, 0x85, 0xdd, 0x08, 0x24,
+	0x99, 0xe5, 0x44, 0x78, 0x3f, 0x6b, 0xac, 0x02, 0x61, 0x09, 0xa1, 0x89, 0x10, 0xc1, 0x74, 0x09,
+	0xe8, 0xb0, 0xba, 0xdf, 0xda, 0xdd, 0x6d, 0x60, 0x29, 0x9f, 0x5c, 0x02, 0xc6, 0x0d, 0xb8, 0x57,
+	0x41, 0x22, 0x16, 0xde, 0x04, 0x23, 0x74, 0x18, 0x48, 0x57, 0x69, 0x09, 0x5e, 0xc8, 0x75, 0xda,
+	0xf2, 0x64, 0x8f, 0x14, 0x28, 0x88, 0xbb, 0xe1, 0xba, 0x50, 0x6a, 0x15, 0xbd, 0xc3, 0x43, 0xdb,
+	0xad, 0x07, 0x92, 0xc2, 0x38, 0x57, 0x3a, 0x6d, 0x79, 0x21, 0x59, 0x6a, 0xd5, 0x42, 0x8c, 0x82,
+	0xd2, 0x3c, 0xf8, 0x3e, 0x98, 0x2a, 0xb9, 0xff, 0x83, 0x6b, 0x24, 0x9a, 0xed, 0x35, 0x36, 0x5b,
+	0x21, 0xeb, 0x0e, 0x73, 0x0b, 0xf3, 0x8d, 0x13, 0x60, 0x09, 0xe4, 0xb8, 0x41, 0xeb, 0x95, 0xd2,
+	0xd7, 0xd9, 0xf2, 0x0a, 0xd1, 0x84, 0x22, 0x36, 0x51, 0xfd, 0x10, 0xa3, 0xa0, 0x14, 0x0d, 0xbe,
+	0x03, 0x26, 0xb9, 0x0d, 0xe1, 0x26, 0xb6, 0x89, 0x74, 0x23, 0xb9, 0xf2, 0xa1, 0x8c, 0xcf, 0xdc,
+	0x0a, 0x8a, 0xa1, 0x7b, 0x81, 0x94, 0x5c, 0x82, 0xfd, 0x23, 0xbb, 0xb1, 0x11, 0x48, 0x37, 0x99,
+	0x42, 0x3a, 0x10, 0x27, 0x84, 0xb0, 0x09, 0xa5, 0x68, 0x74, 0x55, 0x10, 0xae, 0x79, 0x47, 0xd8,
+	0x3f, 0xd9, 0xb0, 0x8f, 0x37, 0x02, 0xe9, 0xa5, 0xe4, 0xaa, 0xf8, 0xa1, 0x5b, 0x3d, 0xb4, 0x8f,
+	0xf9, 0xaa, 0xc4, 0x08, 0xf4, 0xc8, 0x41, 0x2d, 0xd7, 0xc5, 0x3e, 0x2d, 0xa9, 0xd9, 0x39, 0x7f,
+	0x2b, 0x59, 0xf6, 0xf8, 0xcc, 0xcf, 0xca, 0xef, 0xa8, 0xec, 0x89, 0x53, 0xe8, 0x8c, 0xf4, 0x63,
+	0x82, 0x7d, 0xd7, 0x6e, 0x74, 0x65, 0x96, 0x99, 0x8c, 0x30, 0x23, 0x1c, 0x22, 0x44, 0xa1, 0x14,
+	0x0d, 0x7e, 0x08, 0xe6, 0x11, 0xb6, 0xeb, 0x34, 0x3a, 0x64, 0xef, 0x92, 0x92, 0x5b, 0xc7, 0xc7,
+	0xd5, 0x03, 0xfc, 0x54, 0xba, 0x97, 0xcf, 0x2c, 0x0d, 0x17, 0xae, 0x77, 0xda, 0x72, 0x3e, 0x9a,
+	0x99, 0x5d, 0xe7, 0xd3, 0xf2, 0xed, 0x5d, 0xba, 0x52, 0x75, 0x7c, 0xac, 0x06, 0x07, 0xf8, 0xa9,
+	0x82, 0xfa, 0x4b, 0xc0, 0x0d, 0x30, 0xcb, 0x1d, 0x8e, 0x5b, 0x25, 0x3e, 0x0e, 0x82, 0x47, 0x9b,
+	0x55, 0xe9, 0x35, 0x76, 0xf2, 0x08, 0x17, 0x64, 0xa8, 0xeb, 0xb8, 0x6a, 0xc0, 0x40, 0xea, 0x47,
+	0x4d, 0xba, 0x25, 0x53, 0x4c, 0x58, 0x04, 0xe3, 0x7c, 0x80, 0xfd, 0x40, 0xc2, 0xf9, 0xec, 0xd2,
+	0xc4, 0xdd, 0x99, 0xe8, 0x76, 0x0b, 0xed, 0x62, 0x0f, 0x13, 0x44, 0x58, 0x05, 0xf5, 0x78, 0xf0,
+	0x0e, 0x18, 0x63, 0x6f, 0x3c, 0xd5, 0xd8, 0xcd, 0x67, 0xe3, 0x57, 0x55, 0x2d, 0xf4, 0x28, 0xa8,
+	0x0b, 0xa2, 0xf5, 0x21, 0x67, 0xaf, 0xe3, 0x13, 0xd6, 0x8b, 0xb2, 0x0e, 0x62, 0x44, 0xcc, 0x79,
+	0x18, 0x37, 0xad, 0x3b, 0x02, 0xe7, 0x63, 0xac, 0xa0, 0x38, 0x03, 0x3e, 0x02, 0x30, 0x66, 0x30,
+	0x6c, 0x7f, 0x0f, 0xf3, 0x16, 0x62, 0xa4, 0x90, 0xef, 0xb4, 0xe5, 0xcb, 0x7d, 0x75, 0xd4, 0x06,
+	0xc5, 0x29, 0xa8, 0x0f, 0x19, 0x3e, 0x06, 0xe7, 0x7a, 0xd6, 0xd6, 0xee, 0xae, 0x73, 0x8c, 0x6c,
+	0x77, 0x0f, 0x4b, 0x5f, 0x72, 0x51, 0xa5, 0xd3, 0x96, 0x17, 0xd3, 0xa2, 0x0c, 0xa8, 0xfa, 0x14,
+	0xa9, 0xa0, 0xbe, 0x02, 0xd0, 0x06, 0x17, 0xfa, 0xd9, 0xcd, 0x63, 0x57, 0xfa, 0x8a, 0x6b, 0xdf,
+	0xec, 0xb4, 0x65, 0xe5, 0x54, 0x6d, 0x95, 0x1c, 0xbb, 0x0a, 0x1a, 0xa4, 0x03, 0xd7, 0xc0, 0x4c,
+	0xd7, 0x65, 0x1e, 0xbb, 0x95, 0x66, 0x20, 0x7d, 0xcd, 0xa5, 0x85, 0xdd, 0x2b, 0x48, 0x93, 0x63,
+	0x57, 0xf5, 0xe8, 0x9e, 0x48, 0xd2, 0xe8, 0xeb, 0xc8, 0x4d, 0xbc, 0xd2, 0x0d, 0x78, 0x3b, 0x35,
+	0x22, 0x56, 0xa3, 0xa1, 0x0e, 0xaf, 0x91, 0x03, 0x05, 0xc5, 0x09, 0xf0, 0x35, 0x30, 0xde, 0xdb,
+	0x9a, 0x9f, 0x72, 0xb6, 0x50, 0x37, 0x88, 0x3b, 0xb2, 0x07, 0xa4, 0x57, 0x3e, 0x1f, 0x14, 0xec,
+	0xda, 0x81, 0xb7, 0xbb, 0x4b, 0xc9, 0x9f, 0x0d, 0x0d, 0x98, 0xc2, 0x0e, 0xc7, 0x70, 0x91, 0x14,
+	0x4f, 0xf9, 0x10, 0x8c, 0x45, 0xbb, 0x93, 0xde, 0x42, 0xe6, 0x49, 0x33, 0xfc, 0x22, 0x23, 0xde,
+	0x42, 0xe4, 0xa4, 0x89, 0x15, 0xc4, 0x9c, 0xf0, 0x16, 0x18, 0x7d, 0x8c, 0x9d, 0xbd, 0x7d, 0xc2,
+	0x6a, 0xa7, 0x4c, 0x61, 0xb6, 0xd3, 0x96, 0xa7, 0x38, 0xec, 0x29, 0xb3, 0x2b, 0x28, 0x04, 0x28,
+	0xff, 0x37, 0xc3, 0x5b, 0x44, 0x2a, 0xdc, 0xfb, 0xd4, 0x23, 0x0a, 0xbb, 0xf6, 0x21, 0x15, 0xa6,
+	0x4e, 0xb1, 0x88, 0x1b, 0x7a, 0x81, 0x22, 0x6e, 0x19, 0x8c, 0x3e, 0xd6, 0x8c, 0x15, 0x27, 0x2a,
+	0xcc, 0x84, 0x1a, 0xee, 0xa9, 0xdd, 0xe0, 0xe0, 0x10, 0x01, 0x2b, 0x60, 0x6e, 0x0d, 0xdb, 0x3e,
+	0xd9, 0xc1, 0xb6, 0x78, 0x0a, 0x4f, 0x24, 0xaf, 0x83, 0xfd, 0x08, 0xd4, 0x3d, 0x88, 0x15, 0xd4,
+	0x8f, 0x09, 0x4b, 0x60, 0x56, 0x6f, 0xe0, 0x1a, 0xfd, 0x58, 0x66, 0x3a, 0x87, 0xd8, 0x6b, 0x91,
+	0x8d, 0x80, 0x55, 0x5d, 0x59, 0xf1, 0x24, 0xc5, 0x21, 0x44, 0x25, 0x1c, 0xa3, 0xa0, 0x34, 0x8b,
+	0x1e, 0xa6, 0x86, 0x13, 0x10, 0xec, 0x0a, 0x1f, 0xbb, 0xe6, 0x93, 0xb7, 0x66, 0x83, 0x21, 0xa2,
+	0xbe, 0xbc, 0xe5, 0x37, 0x68, 0x2e, 0x93, 0x34, 0x88, 0xc0, 0x9c, 0x56, 0x3f, 0xc2, 0x3e, 0x71,
+	0x02, 0x2c, 0xa8, 0x9d, 0x67, 0x6a, 0xc2, 0x8b, 0x6e, 0x47, 0xa0, 0xb8, 0x60, 0x3f, 0x32, 0x7c,
+	0x2b, 0xea, 0x4f, 0xb5, 0x16, 0xf1, 0x4c, 0xa3, 0x1a, 0x56, 0x4f, 0x42, 0x6e, 0xec, 0x16, 0xf1,
+	0x54, 0x42, 0x05, 0xe2, 0x48, 0x7a, 0xd7, 0xf4, 0xfa, 0x65, 0xad, 0x45, 0xf6, 0xc3, 0x82, 0x69,
+	0x40, 0x8b, 0x6d, 0xb7, 0x12, 0x2d, 0x36, 0xa5, 0xc0, 0x77, 0x44, 0x11, 0xfa, 0x95, 0x4e, 0x5a,
+	0x48, 0x7e, 0x2d, 0x62, 0xec, 0x5d, 0x87, 0x16, 0x2e, 0x09, 0x6c, 0x2f, 0xfa, 0x75, 0x7c, 0xc2,
+	0xc8, 0x17, 0x93, 0x3b, 0x8b, 0xbe, 0xe1, 0x9c, 0x1b, 0x47, 0x42, 0x23, 0xd5, 0xff, 0x32, 0x81,
+	0x4b, 0xc9, 0xee, 0x5c, 0xe8, 0xad, 0xb8, 0x4e, 0x3f, 0x1a, 0x5d, 0x0b, 0x9e, 0x2e, 0xda, 0x78,
+	0xb1, 0xac, 0xc8, 0x2c, 0x2b, 0xc2, 0x5a, 0x84, 0x39, 0x66, 0x0d, 0x1b, 0x4f, 0x48, 0x82, 0x02,
+	0x4d, 0x30, 0xdb, 0x4d, 0x51, 0x57, 0x27, 0xcf, 0x74, 0x84, 0x53, 0x31, 0xaa, 0x3e, 0x7b, 0x59,
+	0x16, 0x24, 0xd3, 0x02, 0xb4, 0xac, 0xa4, 0x7f, 0x47, 0xf9, 0xbd, 0xca, 0x72, 0x94, 0x6c, 0x6a,
+	0x7b, 0x49, 0x16, 0xc1, 0xf4, 0xab, 0x12, 0x1d, 0x26, 0xd2, 0xac, 0x30, 0x09, 0x61, 0xc3, 0xf1,
+	0x9e, 0x3c, 0x95, 0xeb, 0x3e, 0x5c, 0xda, 0x86, 0x46, 0x0d, 0x3b, 0x5b, 0xef, 0x6b, 0x83, 0xfb,
+	0x7b, 0xbe, 0xdc, 0x31, 0x78, 0x34, 0x99, 0x28, 0xdd, 0xd7, 0x07, 0x76, 0xe8, 0x9c, 0x2c, 0x82,
+	0x69, 0xbd, 0x10, 0x6b, 0x8b, 0x99, 0xc2, 0x8d, 0xe7, 0x35, 0xd4, 0x5c, 0x28, 0xcd, 0xa4, 0x9d,
+	0x4b, 0x54, 0xea, 0x37, 0x5a, 0xec, 0x2b, 0xf9, 0xad, 0xe4, 0xde, 0xe9, 0x36, 0x0a, 0x1c, 0xa0,
+	0xa0, 0x04, 0x83, 0xbe, 0xd1, 0x71, 0x0b, 0xfd, 0x50, 0x8b, 0xc3, 0x62, 0x4b, 0x58, 0xe0, 0x84,
+	0x90, 0x1a, 0x50, 0x98, 0x82, 0xfa, 0x91, 0xd3, 0x9a, 0xa6, 0x77, 0x80, 0x5d, 0xe9, 0xe5, 0xe7,
+	0x69, 0x12, 0x0a, 0x53, 0x50, 0x3f, 0x32, 0x7c, 0x00, 0xa6, 0xa2, 0x9e, 0xbe, 0xe8, 0xb5, 0x5c,
+	0xc2, 0xca, 0xb7, 0x6c, 0xec, 0x22, 0x0c, 0xdd, 0x6a, 0x8d, 0xfa, 0xe9, 0x45, 0x28, 0xe2, 0xe9,
+	0x77, 0xda, 0x47, 0x2d, 0x8f, 0xd8, 0xf4, 0x66, 0xc2, 0x6e, 0xbd, 0x70, 0x42, 0x70, 0xc0, 0x6a,
+	0xb5, 0xac, 0xd8, 0xc6, 0x7e, 0x44, 0x21, 0xec, 0x46, 0xc3, 0x6e, 0x5d, 0xdd, 0xa1, 0x20, 0x05,
+	0xa5, 0x89, 0xf4, 0x2a, 0xd9, 0xf4, 0xf1, 0xb6, 0x47, 0xb0, 0xf4, 0x20, 0x79, 0x5c, 0x35, 0x7d,
+	0xac, 0x1e, 0x79, 0x74, 0x75, 0x22, 0x8c, 0xb8, 0x22, 0x62, 0x7b, 0xf7, 0x7e, 0x72, 0x1b, 0x0f,
+	0xe8, 0xeb, 0xfa, 0x91, 0xe9, 0x35, 0x69, 0x78, 0x7b, 0x7b, 0xd8, 0x97, 0x56, 0xd9, 0xc2, 0x0a,
+	0xd7, 0x64, 0x83, 0xd9, 0x15, 0x14, 0x02, 0x68, 0x6b, 0x6c, 0x78, 0x7b, 0x95, 0x16, 0x69, 0xb6,
+	0x48, 0x20, 0xad, 0xb1, 0xf7, 0x59, 0x68, 0x8d, 0x1b, 0xde, 0x9e, 0xea, 0x71, 0xa7, 0x82, 0x04,
+	0x24, 0xfd, 0x84, 0x6e, 0x78, 0x7b, 0x06, 0x3e, 0xc2, 0x0d, 0xa9, 0x94, 0x3c, 0x14, 0x29, 0xab,
+	0x41, 0x5d, 0x0a, 0xea, 0xa2, 0x96, 0xff, 0x9d, 0x01, 0x93, 0xd1, 0x6d, 0xcf, 0x2e, 0x73, 0x08,
+	0xa6, 0xd7, 0xb7, 0xad, 0xc7, 0xa8, 0x64, 0xea, 0x56, 0x75, 0x43, 0x33, 0x8c, 0xdc, 0x99, 0x98,
+	0xcd, 0xd0, 0xd0, 0xaa, 0x9e, 0xcb, 0xc0, 0x39, 0x30, 0xb3, 0xbe, 0x6d, 0x21, 0x5d, 0x5b, 0xb1,
+	0x2a, 0x65, 0xdd, 0x5a, 0xd7, 0x3f, 0xc8, 0x0d, 0xc1, 0x59, 0x30, 0x15, 0x19, 0x91, 0x56, 0x5e,
+	0xd5, 0x73, 0x59, 0x38, 0x0f, 0x66, 0xd7, 0xb7, 0xad, 0x15, 0xdd, 0xd0, 0x4d, 0xbd, 0x8b, 0x1c,
+	0x0e, 0xe9, 0xa1, 0x99, 0x63, 0x47, 0xe0, 0x05, 0x30, 0xb7, 0xbe, 0x6d, 0x99, 0x4f, 0xca, 0xe1,
+	0xb3, 0xb8, 0x3b, 0x37, 0x0a, 0xc7, 0xc1, 0x88, 0xa1, 0x6b, 0x55, 0x3d, 0x07, 0x28, 0x51, 0x37,
+	0xf4, 0xa2, 0x59, 0xaa, 0x94, 0x2d, 0xb4, 0x55, 0x2e, 0xeb, 0x28, 0x77, 0x0e, 0xe6, 0xc0, 0xe4,
+	0x63, 0xcd, 0x2c, 0xae, 0x45, 0x16, 0x99, 0x3e, 0xd6, 0xa8, 0x14, 0xd7, 0x2d, 0xa4, 0x15, 0x75,
+	0x14, 0x99, 0x6f, 0x51, 0x20, 0x13, 0x8a, 0x2c, 0xf7, 0x96, 0x0b, 0xe0, 0x6c, 0x58, 0x59, 0xc3,
+	0x09, 0x70, 0x76, 0x7d, 0xdb, 0x5a, 0xd3, 0xaa, 0x6b, 0xb9, 0x33, 0x3d, 0xa4, 0xfe, 0x64, 0xb3,
+	0x84, 0xe8, 0x8c, 0x01, 0x18, 0x0d, 0x59, 0x43, 0x70, 0x12, 0x8c, 0x95, 0x2b, 0x56, 0x71, 0x4d,
+	0x2f, 0xae, 0xe7, 0xb2, 0xcb, 0x3f, 0xce, 0x0a, 0xff, 0x9b, 0x06, 0x67, 0xc0, 0x44, 0xb9, 0x62,
+	0x5a, 0x55, 0x53, 0x43, 0xa6, 0xbe, 0x92, 0x3b, 0x03, 0xcf, 0x03, 0x58, 0x2a, 0x97, 0xcc, 0x92,
+	0x66, 0x70, 0xa3, 0xa5, 0x9b, 0xc5, 0x95, 0x1c, 0xa0, 0x8f, 0x40, 0xba, 0x60, 0x99, 0xa0, 0x96,
+	0x6a, 0x69, 0xd5, 0xd4, 0xd1, 0x06, 0xb7, 0x9c, 0x83, 0x79, 0x70, 0xb9, 0x5a, 0x5a, 0x7d, 0xb4,
+	0x55, 0xe2, 0x18, 0x4b, 0x2b, 0xaf, 0x58, 0x48, 0xdf, 0xa8, 0x6c, 0xeb, 0xd6, 0x8a, 0x66, 0x6a,
+	0xb9, 0x79, 0xba, 0xe6, 0x55, 0x6d, 0x5b, 0xb7, 0xaa, 0x65, 0x6d, 0xb3, 0xba, 0x56, 0x31, 0x73,
+	0x8b, 0xf0, 0x2a, 0xb8, 0x42, 0x85, 0x2b, 0x48, 0xb7, 0xa2, 0x07, 0x3c, 0x44, 0x95, 0x8d, 0x1e,
+	0x44, 0x86, 0x0b, 0x60, 0xbe, 0xbf, 0x2b, 0x4f, 0xd9, 0xa9, 0x47, 0x6a, 0xa8, 0xb8, 0x56, 0x8a,
+	0x9e, 0xb9, 0x04, 0xef, 0x80, 0x97, 0x4f, 0x8b, 0x8a, 0x8d, 0xab, 0x66, 0x65, 0xd3, 0xd2, 0x56,
+	0xf5, 0xb2, 0x99, 0xbb, 0x05, 0xaf, 0x80, 0x85, 0x82, 0xa1, 0x15, 0xd7, 0xd7, 0x2a, 0x86, 0x6e,
+	0x6d, 0xea, 0x3a, 0xb2, 0x36, 0x2b, 0xc8, 0xb4, 0xcc, 0x27, 0x16, 0x7a, 0x92, 0xab, 0x43, 0x19,
+	0x5c, 0xda, 0x2a, 0x0f, 0x06, 0x60, 0x78, 0x11, 0xcc, 0xaf, 0xe8, 0x86, 0xf6, 0x41, 0xca, 0xf5,
+	0x2c, 0x03, 0x2f, 0x83, 0x0b, 0x5b, 0xe5, 0xfe, 0xde, 0xcf, 0x33, 0xcb, 0x7f, 0x06, 0x60, 0x98,
+	0x7e, 0x8d, 0x80, 0x12, 0x38, 0x17, 0xad, 0x2d, 0xdd, 0x86, 0x0f, 0x2b, 0x86, 0x51, 0x79, 0xac,
+	0xa3, 0xdc, 0x99, 0x70, 0x36, 0x29, 0x8f, 0xb5, 0x55, 0x36, 0x4b, 0x86, 0x65, 0xa2, 0xd2, 0xea,
+	0xaa, 0x8e, 0x7a, 0x2b, 0x94, 0xa1, 0xef, 0x43, 0x44, 0x30, 0x74, 0x6d, 0x85, 0xed, 0x88, 0x5b,
+	0xe0, 0x46, 0xdc, 0x36, 0x88, 0x9e, 0x15, 0xe9, 0x8f, 0xb6, 0x2a, 0x68, 0x6b, 0x23, 0x37, 0x4c,
+	0x37, 0x4d, 0x64, 0xa3, 0xef, 0xdc, 0x08, 0xbc, 0x06, 0xe4, 0x68, 0x89, 0x85, 0xd5, 0x8d, 0x45,
+	0x0e, 0xe0, 0x7d, 0xf0, 0xfa, 0x73, 0x40, 0x83, 0xa2, 0x98, 0xa0, 0x29, 0xe9, 0xc3, 0x0d, 0xe7,
+	0x33, 0x09, 0x5f, 0x03, 0xaf, 0x0e, 0x74, 0x0f, 0x12, 0x9d, 0x82, 0x0f, 0x41, 0xa1, 0x0f, 0x8b,
+	0xcf, 0x32, 0xb4, 0xf0, 0x7d, 0x19, 0x0a, 0x45, 0xd4, 0x70, 0x13, 0x16, 0x11, 0x7d, 0x8b, 0x73,
+	0xd3, 0x70, 0x19, 0xdc, 0x1c, 0xb8, 0x1d, 0xe2, 0x8b, 0x50, 0x87, 0x1a, 0x78, 0xf7, 0xc5, 0xb0,
+	0x83, 0xc2, 0xc6, 0xf0, 0x3a, 0xc8, 0x0f, 0x96, 0x08, 0x97, 0x64, 0x17, 0xbe, 0x0d, 0xde, 0x78,
+	0x1e, 0x6a, 0xd0, 0x23, 0xf6, 0x4e, 0x7f, 0x44, 0xb8, 0x0d, 0xf6, 0xe9, 0xbb, 0x37, 0x18, 0x45,
+	0x37, 0x86, 0x03, 0x5f, 0x02, 0x4a, 0xdf, 0xcd, 0x1e, 0x5f, 0x96, 0x67, 0x19, 0x78, 0x1b, 0xdc,
+	0x42, 0x5a, 0x79, 0xa5, 0xb2, 0x61, 0xbd, 0x00, 0xfe, 0xf3, 0x0c, 0x7c, 0x0f, 0xbc, 0xf5, 0x7c,
+	0xe0, 0xa0, 0x09, 0x7e, 0x91, 0x81, 0x3a, 0x78, 0xff, 0x85, 0x9f, 0x37, 0x48, 0xe6, 0xcb, 0x0c,
+	0xbc, 0x0a, 0x2e, 0xf7, 0xe7, 0x87, 0x79, 0xf8, 0x2a, 0x03, 0x97, 0xc0, 0xb5, 0x53, 0x9f, 0x14,
+	0x22, 0xbf, 0xce, 0xc0, 0x37, 0xc1, 0xbd, 0xd3, 0x20, 0x83, 0xc2, 0xf8, 0x45, 0x06, 0x3e, 0x00,
+	0xf7, 0x5f, 0xe0, 0x19, 0x83, 0x04, 0x7e, 0x79, 0xca, 0x3c, 0xc2, 0x64, 0x7f, 0xf3, 0xfc, 0x79,
+	0x84, 0xc8, 0x5f, 0x65, 0xe0, 0x22, 0x58, 0xe8, 0x0f, 0xa1, 0x7b, 0xe2, 0xd7, 0x19, 0x78, 0x03,
+	0xe4, 0x4f, 0x55, 0xa2, 0xb0, 0xdf, 0x64, 0xa0, 0x04, 0xe6, 0xca, 0x15, 0xeb, 0xa1, 0x56, 0x32,
+	0xac, 0xc7, 0x25, 0x73, 0xcd, 0xaa, 0x9a, 0x48, 0xaf, 0x56, 0x73, 0x3f, 0x1d, 0xa2, 0xa1, 0xc4,
+	0x3c, 0xe5, 0x4a, 0xe8, 0xb4, 0x1e, 0x56, 0x90, 0x65, 0x94, 0xb6, 0xf5, 0x32, 0x45, 0x7e, 0x32,
+	0x04, 0x67, 0x00, 0xa0, 0xb0, 0xcd, 0x4a, 0xa9, 0x6c, 0x56, 0x73, 0xff, 0x9f, 0x85, 0x53, 0x60,
+	0x4c, 0x7f, 0x62, 0xea, 0xa8, 0xac, 0x19, 0xb9, 0xbf, 0x64, 0xef, 0x3e, 0x00, 0xe3, 0xa6, 0x6f,
+	0xbb, 0x01, 0xfd, 0x2f, 0x0d, 0x78, 0x57, 0x1c, 0x4c, 0x87, 0xdf, 0xc6, 0xc2, 0xdf, 0xa0, 0x5c,
+	0x9c, 0xe9, 0x8e, 0xf9, 0xcf, 0x13, 0x94, 0x33, 0x4b, 0x99, 0x57, 0x33, 0x85, 0x73, 0xcf, 0xfe,
+	0xb0, 0x78, 0xe6, 0xd9, 0xb7, 0x8b, 0x99, 0x6f, 0xbe, 0x5d, 0xcc, 0xfc, 0xfe, 0xdb, 0xc5, 0xcc,
+	0x8f, 0xfe, 0xb8, 0x78, 0x66, 0x67, 0x94, 0xfd, 0x86, 0xe5, 0xde, 0x7f, 0x06, 0x00, 0x88, 0x86,
+	0xf4, 0xd2, 0x0c, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1835,6 +1887,13 @@ func (m *Tester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.StressBackoffQPS != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.StressBackoffQPS))
+		i--
+		dAtA[i] = 0x12
+		i--
+		dAtA[i] = 0xf8
+	}
 	if m.StressQPS != 0 {
 		i = encodeVarintRpc(dAtA, i, uint64(m.StressQPS))
 		i--
@@ -1911,6 +1970,20 @@ func (m *Tester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xaa
 		}
 	}
+	if m.ReadyMinStressQPS != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.ReadyMinStressQPS))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ReadyMaxRaftIndexSkew != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.ReadyMaxRaftIndexSkew))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
 	if len(m.ExternalExecPath) > 0 {
 		i -= len(m.ExternalExecPath)
 		copy(dAtA[i:], m.ExternalExecPath)
@@ -1929,6 +2002,41 @@ func (m *Tester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xca
 	}
+	if m.RecoveryMaxMs != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.RecoveryMaxMs))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.InjectIntervalMs != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.InjectIntervalMs))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.InjectRepeat != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.InjectRepeat))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.InjectAtRevision != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.InjectAtRevision))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.InjectDelayMs != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.InjectDelayMs))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
 	if len(m.FailpointCommands) > 0 {
 		for iNdEx := len(m.FailpointCommands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.FailpointCommands[iNdEx])
@@ -1970,6 +2078,25 @@ func (m *Tester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xf8
 	}
+	if m.Seed != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Seed))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.RandomInitialCorruptCheck {
+		i--
+		if m.RandomInitialCorruptCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
 	if m.EnablePprof {
 		i--
 		if m.EnablePprof {
@@ -2016,6 +2143,13 @@ func (m *Tester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
+	if len(m.ReportDir) > 0 {
+		i -= len(m.ReportDir)
+		copy(dAtA[i:], m.ReportDir)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.ReportDir)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Addr) > 0 {
 		i -= len(m.Addr)
 		copy(dAtA[i:], m.Addr)
@@ -2610,6 +2744,10 @@ func (m *Tester) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpc(uint64(l))
 	}
+	l = len(m.ReportDir)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
 	if m.DelayLatencyMs != 0 {
 		n += 1 + sovRpc(uint64(m.DelayLatencyMs))
 	}
@@ -2628,6 +2766,12 @@ func (m *Tester) Size() (n int) {
 	if m.EnablePprof {
 		n += 3
 	}
+	if m.RandomInitialCorruptCheck {
+		n += 3
+	}
+	if m.Seed != 0 {
+		n += 2 + sovRpc(uint64(m.Seed))
+	}
 	if m.CaseDelayMs != 0 {
 		n += 2 + sovRpc(uint64(m.CaseDelayMs))
 	}
@@ -2646,6 +2790,21 @@ func (m *Tester) Size() (n int) {
 			n += 2 + l + sovRpc(uint64(l))
 		}
 	}
+	if m.InjectDelayMs != 0 {
+		n += 2 + sovRpc(uint64(m.InjectDelayMs))
+	}
+	if m.InjectAtRevision != 0 {
+		n += 2 + sovRpc(uint64(m.InjectAtRevision))
+	}
+	if m.InjectRepeat != 0 {
+		n += 2 + sovRpc(uint64(m.InjectRepeat))
+	}
+	if m.InjectIntervalMs != 0 {
+		n += 2 + sovRpc(uint64(m.InjectIntervalMs))
+	}
+	if m.RecoveryMaxMs != 0 {
+		n += 2 + sovRpc(uint64(m.RecoveryMaxMs))
+	}
 	l = len(m.RunnerExecPath)
 	if l > 0 {
 		n += 2 + l + sovRpc(uint64(l))
@@ -2654,6 +2813,12 @@ func (m *Tester) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRpc(uint64(l))
 	}
+	if m.ReadyMaxRaftIndexSkew != 0 {
+		n += 2 + sovRpc(uint64(m.ReadyMaxRaftIndexSkew))
+	}
+	if m.ReadyMinStressQPS != 0 {
+		n += 2 + sovRpc(uint64(m.ReadyMinStressQPS))
+	}
 	if len(m.Stressers) > 0 {
 		for _, e := range m.Stressers {
 			l = e.Size()
@@ -2687,6 +2852,9 @@ func (m *Tester) Size() (n int) {
 	if m.StressQPS != 0 {
 		n += 2 + sovRpc(uint64(m.StressQPS))
 	}
+	if m.StressBackoffQPS != 0 {
+		n += 2 + sovRpc(uint64(m.StressBackoffQPS))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4407,6 +4575,38 @@ func (m *Tester) Unmarshal(dAtA []byte) error {
 			}
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 11:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelayLatencyMs", wireType)
@@ -4523,6 +4723,45 @@ func (m *Tester) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnablePprof = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandomInitialCorruptCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RandomInitialCorruptCheck = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			m.Seed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 31:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CaseDelayMs", wireType)
@@ -4626,6 +4865,101 @@ func (m *Tester) Unmarshal(dAtA []byte) error {
 			}
 			m.FailpointCommands = append(m.FailpointCommands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InjectDelayMs", wireType)
+			}
+			m.InjectDelayMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InjectDelayMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InjectAtRevision", wireType)
+			}
+			m.InjectAtRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InjectAtRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InjectRepeat", wireType)
+			}
+			m.InjectRepeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InjectRepeat |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InjectIntervalMs", wireType)
+			}
+			m.InjectIntervalMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InjectIntervalMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryMaxMs", wireType)
+			}
+			m.RecoveryMaxMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryMaxMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 41:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RunnerExecPath", wireType)
@@ -4690,6 +5024,44 @@ func (m *Tester) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalExecPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyMaxRaftIndexSkew", wireType)
+			}
+			m.ReadyMaxRaftIndexSkew = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyMaxRaftIndexSkew |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyMinStressQPS", wireType)
+			}
+			m.ReadyMinStressQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyMinStressQPS |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 101:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Stressers", wireType)
@@ -4889,6 +5261,25 @@ func (m *Tester) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 303:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StressBackoffQPS", wireType)
+			}
+			m.StressBackoffQPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StressBackoffQPS |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
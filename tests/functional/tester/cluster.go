@@ -265,6 +265,22 @@ func (clus *Cluster) updateCases() {
 	}
 }
 
+// SetCases replaces the configured test cases.
+func (clus *Cluster) SetCases(cases []string) error {
+	if len(cases) == 0 {
+		return errors.New("cases not found")
+	}
+	for _, v := range cases {
+		if _, ok := rpcpb.Case_value[v]; !ok {
+			return fmt.Errorf("%q is not defined in 'rpcpb.Case_value'", v)
+		}
+	}
+	clus.Tester.Cases = cases
+	clus.cases = make([]Case, 0)
+	clus.updateCases()
+	return nil
+}
+
 func (clus *Cluster) listCases() (css []string) {
 	css = make([]string, len(clus.cases))
 	for i := range clus.cases {
@@ -620,6 +636,139 @@ func (clus *Cluster) WaitHealth() error {
 	return err
 }
 
+// waitReady waits until members are within the configured raft index skew
+// and, if stressers are running, until they reach the configured QPS.
+func (clus *Cluster) waitReady(stressStarted bool) error {
+	maxSkew, minQPS := clus.Tester.ReadyMaxRaftIndexSkew, int64(clus.Tester.ReadyMinStressQPS)
+	if !stressStarted {
+		minQPS = 0
+	}
+	clus.lg.Info(
+		"ready check START",
+		zap.Uint64("max-raft-index-skew", maxSkew),
+		zap.Int64("min-stress-qps", minQPS),
+	)
+	if maxSkew == 0 && minQPS == 0 {
+		return nil
+	}
+
+	var err error
+	for i := 0; i < 60; i++ {
+		qps := clus.stressQPS()
+
+		var skew uint64
+		skew, err = clus.raftIndexSkew()
+		if err == nil && maxSkew > 0 && skew > maxSkew {
+			err = fmt.Errorf("raft index skew %d exceeds %d", skew, maxSkew)
+		}
+		if err == nil && qps < minQPS {
+			err = fmt.Errorf("stresser QPS %d is below %d", qps, minQPS)
+		}
+		if err == nil {
+			clus.lg.Info(
+				"ready check PASS",
+				zap.Int("retries", i),
+				zap.Uint64("raft-index-skew", skew),
+				zap.Int64("stress-qps", qps),
+			)
+			return nil
+		}
+		clus.lg.Warn(
+			"ready check FAIL",
+			zap.Int("retries", i),
+			zap.Error(err),
+		)
+	}
+	return err
+}
+
+// waitInject waits InjectDelayMs after stressers started at stressNow
+// and until the cluster reaches InjectAtRevision.
+// It returns immediately if stressers have not been started.
+func (clus *Cluster) waitInject(stressNow time.Time) error {
+	if stressNow.IsZero() {
+		return nil
+	}
+	if d := time.Until(stressNow.Add(time.Duration(clus.Tester.InjectDelayMs) * time.Millisecond)); d > 0 {
+		clus.lg.Info("wait before inject", zap.Duration("delay", d))
+		time.Sleep(d)
+	}
+
+	rev := clus.Tester.InjectAtRevision
+	if rev <= 0 {
+		return nil
+	}
+	clus.lg.Info("wait revision before inject", zap.Int64("revision", rev))
+	// fail if stressers stop making progress for 10 seconds
+	var last int64
+	progressed := time.Now()
+	for {
+		cur, err := clus.maxRev()
+		if err == nil && cur >= rev {
+			clus.lg.Info("reached revision", zap.Int64("revision", cur))
+			return nil
+		}
+		if cur > last {
+			last, progressed = cur, time.Now()
+		}
+		if time.Since(progressed) > 10*time.Second {
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("revision stuck at %d before reaching %d", last, rev)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// stressQPS measures the stresser requests per second over one second.
+func (clus *Cluster) stressQPS() int64 {
+	reqs := clus.stresser.Requests()
+	time.Sleep(time.Second)
+	return clus.stresser.Requests() - reqs
+}
+
+// raftIndexSkew returns the difference between the highest and
+// the lowest raft index found on the cluster.
+func (clus *Cluster) raftIndexSkew() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+	var minIdx, maxIdx uint64
+	for i, m := range clus.Members {
+		idx, err := m.RaftIndex(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("%v (%q)", err, m.EtcdClientEndpoint)
+		}
+		if i == 0 || idx < minIdx {
+			minIdx = idx
+		}
+		if idx > maxIdx {
+			maxIdx = idx
+		}
+	}
+	return maxIdx - minIdx, nil
+}
+
+// backoffStress lowers the shared stresser rate limit to StressBackoffQPS,
+// so that stressers do not overwhelm the cluster while a failure is injected
+// and recovered. It returns a function that restores the previous limit.
+func (clus *Cluster) backoffStress() (restore func()) {
+	if clus.Tester.StressBackoffQPS == 0 {
+		return func() {}
+	}
+	lmt := clus.rateLimiter.Limit()
+	clus.rateLimiter.SetLimit(rate.Limit(clus.Tester.StressBackoffQPS))
+	clus.lg.Info(
+		"stress BACKOFF",
+		zap.Float64("limit", float64(lmt)),
+		zap.Int32("backoff-limit", clus.Tester.StressBackoffQPS),
+	)
+	return func() {
+		clus.rateLimiter.SetLimit(lmt)
+		clus.lg.Info("stress CATCH UP", zap.Float64("limit", float64(lmt)))
+	}
+}
+
 // GetLeader returns the index of leader and error if any.
 func (clus *Cluster) GetLeader() (int, error) {
 	for i, m := range clus.Members {
@@ -80,6 +80,14 @@ func read(lg *zap.Logger, fpath string) (*Cluster, error) {
 		}
 	}
 
+	if clus.Tester.ReadyMinStressQPS > clus.Tester.StressQPS {
+		return nil, fmt.Errorf("ReadyMinStressQPS %d must not exceed StressQPS %d", clus.Tester.ReadyMinStressQPS, clus.Tester.StressQPS)
+	}
+
+	if clus.Tester.StressBackoffQPS > clus.Tester.StressQPS {
+		return nil, fmt.Errorf("StressBackoffQPS %d must not exceed StressQPS %d", clus.Tester.StressBackoffQPS, clus.Tester.StressQPS)
+	}
+
 	if clus.Tester.StressKeySuffixRangeTxn > 100 {
 		return nil, fmt.Errorf("StressKeySuffixRangeTxn maximum value is 100, got %v", clus.Tester.StressKeySuffixRangeTxn)
 	}
@@ -16,6 +16,7 @@ package tester
 
 import (
 	"fmt"
+	"math/rand"
 	"os"
 	"time"
 
@@ -31,7 +32,14 @@ const compactQPS = 50000
 
 // Run starts tester.
 func (clus *Cluster) Run() {
-	defer printReport()
+	defer clus.report()
+
+	seed := clus.Tester.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rand.Seed(seed)
+	clus.lg.Info("seeded random", zap.Int64("seed", seed))
 
 	if err := fileutil.TouchDirAll(clus.Tester.DataDir); err != nil {
 		clus.lg.Panic(
@@ -119,6 +127,9 @@ func (clus *Cluster) doRound() error {
 	if clus.Tester.CaseShuffle {
 		clus.shuffleCases()
 	}
+	if clus.Tester.RandomInitialCorruptCheck {
+		clus.randomizeInitialCorruptCheck()
+	}
 
 	roundNow := time.Now()
 	clus.lg.Info(
@@ -148,7 +159,7 @@ func (clus *Cluster) doRound() error {
 			return fmt.Errorf("wait full health error: %v", err)
 		}
 
-		stressStarted := false
+		stressStarted, stressNow := false, time.Time{}
 		fcase := fa.TestCase()
 		if fcase != rpcpb.Case_NO_FAIL_WITH_NO_STRESS_FOR_LIVENESS {
 			clus.lg.Info(
@@ -161,32 +172,35 @@ func (clus *Cluster) doRound() error {
 			if err := clus.stresser.Stress(); err != nil {
 				return fmt.Errorf("start stresser error: %v", err)
 			}
-			stressStarted = true
+			stressStarted, stressNow = true, time.Now()
 		}
 
-		clus.lg.Info(
-			"inject START",
-			zap.Int("round", clus.rd),
-			zap.Int("case", clus.cs),
-			zap.Int("case-total", len(clus.cases)),
-			zap.String("desc", fa.Desc()),
-		)
-		if err := fa.Inject(clus); err != nil {
-			return fmt.Errorf("injection error: %v", err)
+		if err := clus.waitReady(stressStarted); err != nil {
+			return fmt.Errorf("wait ready error: %v", err)
 		}
 
-		// if run local, recovering server may conflict
-		// with stressing client ports
-		// TODO: use unix for local tests
-		clus.lg.Info(
-			"recover START",
-			zap.Int("round", clus.rd),
-			zap.Int("case", clus.cs),
-			zap.Int("case-total", len(clus.cases)),
-			zap.String("desc", fa.Desc()),
-		)
-		if err := fa.Recover(clus); err != nil {
-			return fmt.Errorf("recovery error: %v", err)
+		if err := clus.waitInject(stressNow); err != nil {
+			return fmt.Errorf("wait inject error: %v", err)
+		}
+
+		injects := 1
+		if stressStarted && clus.Tester.InjectRepeat > 1 {
+			injects = int(clus.Tester.InjectRepeat)
+		}
+		for n := 0; n < injects; n++ {
+			if n > 0 {
+				interval := time.Duration(clus.Tester.InjectIntervalMs) * time.Millisecond
+				clus.lg.Info(
+					"wait before next inject",
+					zap.Int("inject", n),
+					zap.Int("inject-total", injects),
+					zap.Duration("interval", interval),
+				)
+				time.Sleep(interval)
+			}
+			if err := clus.injectAndRecover(fa, stressStarted); err != nil {
+				return err
+			}
 		}
 
 		if stressStarted {
@@ -267,6 +281,94 @@ func (clus *Cluster) doRound() error {
 	return nil
 }
 
+// injectAndRecover injects the failure case and recovers the cluster from it.
+func (clus *Cluster) injectAndRecover(fa Case, stressStarted bool) error {
+	clus.lg.Info(
+		"inject START",
+		zap.Int("round", clus.rd),
+		zap.Int("case", clus.cs),
+		zap.Int("case-total", len(clus.cases)),
+		zap.String("desc", fa.Desc()),
+	)
+	restoreStress := clus.backoffStress()
+	if err := fa.Inject(clus); err != nil {
+		restoreStress()
+		return fmt.Errorf("injection error: %v", err)
+	}
+
+	// if run local, recovering server may conflict
+	// with stressing client ports
+	// TODO: use unix for local tests
+	clus.lg.Info(
+		"recover START",
+		zap.Int("round", clus.rd),
+		zap.Int("case", clus.cs),
+		zap.Int("case-total", len(clus.cases)),
+		zap.String("desc", fa.Desc()),
+	)
+	recoverNow := time.Now()
+	err := fa.Recover(clus)
+	restoreStress()
+	if err != nil {
+		return fmt.Errorf("recovery error: %v", err)
+	}
+	return clus.measureRecovery(fa, recoverNow, stressStarted)
+}
+
+// measureRecovery measures how long it takes since recoverNow for all members
+// to become healthy and, if stressing, for stressers to reach ReadyMinStressQPS.
+func (clus *Cluster) measureRecovery(fa Case, recoverNow time.Time, stressStarted bool) error {
+	maxRecovery := time.Duration(clus.Tester.RecoveryMaxMs) * time.Millisecond
+
+	if err := clus.WaitHealth(); err != nil {
+		return fmt.Errorf("wait full health error: %v", err)
+	}
+	healthTook := time.Since(recoverNow)
+	recoveryHealthSeconds.WithLabelValues(fa.Desc()).Observe(healthTook.Seconds())
+	clus.lg.Info(
+		"recovery health PASS",
+		zap.Int("round", clus.rd),
+		zap.Int("case", clus.cs),
+		zap.Int("case-total", len(clus.cases)),
+		zap.String("desc", fa.Desc()),
+		zap.Duration("took", healthTook),
+		zap.Duration("max-recovery", maxRecovery),
+	)
+	if maxRecovery > 0 && healthTook > maxRecovery {
+		return fmt.Errorf("recovery took %v until healthy, exceeds %v", healthTook, maxRecovery)
+	}
+
+	minQPS := int64(clus.Tester.ReadyMinStressQPS)
+	if !stressStarted || minQPS == 0 {
+		return nil
+	}
+	var qps int64
+	for i := 0; i < 60; i++ {
+		if qps = clus.stressQPS(); qps >= minQPS {
+			break
+		}
+	}
+	if qps < minQPS {
+		return fmt.Errorf("stresser QPS %d did not recover to %d", qps, minQPS)
+	}
+	stressTook := time.Since(recoverNow)
+	recoveryStressSeconds.WithLabelValues(fa.Desc()).Observe(stressTook.Seconds())
+	clus.lg.Info(
+		"recovery stress PASS",
+		zap.Int("round", clus.rd),
+		zap.Int("case", clus.cs),
+		zap.Int("case-total", len(clus.cases)),
+		zap.String("desc", fa.Desc()),
+		zap.Duration("took", stressTook),
+		zap.Int64("stress-qps", qps),
+		zap.Duration("max-recovery", maxRecovery),
+	)
+	if maxRecovery > 0 && stressTook > maxRecovery {
+		return fmt.Errorf("recovery took %v until stresser QPS %d, exceeds %v", stressTook, minQPS, maxRecovery)
+	}
+	return nil
+}
+
 func (clus *Cluster) updateRevision() error {
 	revs, _, err := clus.getRevisionHash()
 	for _, rev := range revs {
@@ -324,6 +426,7 @@ func (clus *Cluster) failed() {
 	)
 	clus.Send_SIGQUIT_ETCD_AND_REMOVE_DATA_AND_STOP_AGENT()
 
+	clus.report()
 	os.Exit(2)
 }
 
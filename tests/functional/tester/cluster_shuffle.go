@@ -16,13 +16,11 @@ package tester
 
 import (
 	"math/rand"
-	"time"
 
 	"go.uber.org/zap"
 )
 
 func (clus *Cluster) shuffleCases() {
-	rand.Seed(time.Now().UnixNano())
 	offset := rand.Intn(1000)
 	n := len(clus.cases)
 	cp := coprime(n)
@@ -35,6 +33,18 @@ func (clus *Cluster) shuffleCases() {
 	clus.lg.Info("shuffled test failure cases", zap.Int("total", n))
 }
 
+// randomizeInitialCorruptCheck randomly enables or disables initial
+// corruption check on each member. Members pick up the new flag value
+// the next time the agent restarts them.
+func (clus *Cluster) randomizeInitialCorruptCheck() {
+	enabled := make([]bool, len(clus.Members))
+	for i, m := range clus.Members {
+		m.Etcd.InitialCorruptCheck = rand.Intn(2) == 0
+		enabled[i] = m.Etcd.InitialCorruptCheck
+	}
+	clus.lg.Info("randomized initial corrupt check", zap.Bools("enabled", enabled))
+}
+
 /*
 x and y of GCD 1 are coprime to each other
 
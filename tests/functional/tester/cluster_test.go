@@ -188,17 +188,20 @@ func Test_read(t *testing.T) {
 			},
 		},
 		Tester: &rpcpb.Tester{
-			DataDir:               "/tmp/etcd-tester-data",
-			Network:               "tcp",
-			Addr:                  "127.0.0.1:9028",
-			DelayLatencyMs:        5000,
-			DelayLatencyMsRv:      500,
-			UpdatedDelayLatencyMs: 5000,
-			RoundLimit:            1,
-			ExitOnCaseFail:        true,
-			EnablePprof:           true,
-			CaseDelayMs:           7000,
-			CaseShuffle:           true,
+			DataDir:                   "/tmp/etcd-tester-data",
+			Network:                   "tcp",
+			Addr:                      "127.0.0.1:9028",
+			ReportDir:                 "",
+			DelayLatencyMs:            5000,
+			DelayLatencyMsRv:          500,
+			UpdatedDelayLatencyMs:     5000,
+			RoundLimit:                1,
+			ExitOnCaseFail:            true,
+			EnablePprof:               true,
+			RandomInitialCorruptCheck: false,
+			Seed:                      0,
+			CaseDelayMs:               7000,
+			CaseShuffle:               true,
 			Cases: []string{
 				"SIGTERM_ONE_FOLLOWER",
 				"SIGTERM_ONE_FOLLOWER_UNTIL_TRIGGER_SNAPSHOT",
@@ -232,9 +235,16 @@ func Test_read(t *testing.T) {
 				"NO_FAIL_WITH_STRESS",
 				"NO_FAIL_WITH_NO_STRESS_FOR_LIVENESS",
 			},
-			FailpointCommands: []string{`panic("etcd-tester")`},
-			RunnerExecPath:    "./bin/etcd-runner",
-			ExternalExecPath:  "",
+			FailpointCommands:     []string{`panic("etcd-tester")`},
+			InjectDelayMs:         0,
+			InjectAtRevision:      0,
+			InjectRepeat:          1,
+			InjectIntervalMs:      0,
+			RecoveryMaxMs:         0,
+			RunnerExecPath:        "./bin/etcd-runner",
+			ExternalExecPath:      "",
+			ReadyMaxRaftIndexSkew: 1000,
+			ReadyMinStressQPS:     500,
 			Stressers: []*rpcpb.Stresser{
 				{Type: "KV_WRITE_SMALL", Weight: 0.35},
 				{Type: "KV_WRITE_LARGE", Weight: 0.002},
@@ -253,6 +263,7 @@ func Test_read(t *testing.T) {
 			StressKeyTxnOps:         10,
 			StressClients:           100,
 			StressQPS:               2000,
+			StressBackoffQPS:        1000,
 		},
 	}
 
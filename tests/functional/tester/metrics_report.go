@@ -16,9 +16,15 @@ package tester
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"sort"
+	"strings"
+
+	"go.etcd.io/etcd/pkg/v3/fileutil"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
 )
 
 var (
@@ -44,6 +50,34 @@ var (
 		[]string{"desc"},
 	)
 
+	recoveryHealthSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "etcd",
+			Subsystem: "funcational_tester",
+			Name:      "recovery_health_seconds",
+			Help:      "Bucketed histogram of time from recovery start until all members are healthy.",
+
+			// lowest bucket start of upper bound 0.1 sec (100 ms) with factor 2
+			// highest bucket start of 0.1 sec * 2^11 == 204.8 sec
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+		},
+		[]string{"desc"},
+	)
+
+	recoveryStressSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "etcd",
+			Subsystem: "funcational_tester",
+			Name:      "recovery_stress_seconds",
+			Help:      "Bucketed histogram of time from recovery start until stressers reach the ready QPS.",
+
+			// lowest bucket start of upper bound 0.1 sec (100 ms) with factor 2
+			// highest bucket start of 0.1 sec * 2^11 == 204.8 sec
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+		},
+		[]string{"desc"},
+	)
+
 	roundTotalCounter = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Namespace: "etcd",
@@ -64,20 +98,49 @@ var (
 func init() {
 	prometheus.MustRegister(caseTotalCounter)
 	prometheus.MustRegister(caseFailedTotalCounter)
+	prometheus.MustRegister(recoveryHealthSeconds)
+	prometheus.MustRegister(recoveryStressSeconds)
 	prometheus.MustRegister(roundTotalCounter)
 	prometheus.MustRegister(roundFailedTotalCounter)
 }
 
 func printReport() {
+	rows := reportRows()
+
+	println()
+	for _, row := range rows {
+		fmt.Println(row)
+	}
+	println()
+}
+
+// saveReport writes the report into "report.txt" under dir.
+func saveReport(dir string) error {
+	if err := fileutil.TouchDirAll(dir); err != nil {
+		return err
+	}
+	data := strings.Join(reportRows(), "\n") + "\n"
+	return ioutil.WriteFile(filepath.Join(dir, "report.txt"), []byte(data), fileutil.PrivateFileMode)
+}
+
+func reportRows() []string {
 	rows := make([]string, 0, len(caseTotal))
 	for k, v := range caseTotal {
 		rows = append(rows, fmt.Sprintf("%s: %d", k, v))
 	}
 	sort.Strings(rows)
+	return rows
+}
 
-	println()
-	for _, row := range rows {
-		fmt.Println(row)
+// report prints the report and saves it into the configured report directory.
+func (clus *Cluster) report() {
+	printReport()
+	if clus.Tester.ReportDir == "" {
+		return
 	}
-	println()
+	if err := saveReport(clus.Tester.ReportDir); err != nil {
+		clus.lg.Warn("failed to save report", zap.String("dir", clus.Tester.ReportDir), zap.Error(err))
+		return
+	}
+	clus.lg.Info("saved report", zap.String("path", filepath.Join(clus.Tester.ReportDir, "report.txt")))
 }
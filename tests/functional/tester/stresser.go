@@ -33,6 +33,8 @@ type Stresser interface {
 	Close() map[string]int
 	// ModifiedKeys reports the number of keys created and deleted by stresser
 	ModifiedKeys() int64
+	// Requests reports the number of successful requests sent by stresser
+	Requests() int64
 }
 
 // newStresser creates stresser from a comma separated list of stresser types.
@@ -80,3 +80,10 @@ func (cs *compositeStresser) ModifiedKeys() (modifiedKey int64) {
 	}
 	return modifiedKey
 }
+
+func (cs *compositeStresser) Requests() (reqs int64) {
+	for _, stress := range cs.stressers {
+		reqs += stress.Requests()
+	}
+	return reqs
+}
@@ -70,6 +70,8 @@ type keyStresser struct {
 
 	// atomicModifiedKeys records the number of keys created and deleted by the stresser.
 	atomicModifiedKeys int64
+	// atomicRequests records the number of successful requests sent by the stresser.
+	atomicRequests int64
 
 	stressTable *stressTable
 }
@@ -126,6 +128,7 @@ func (s *keyStresser) run() {
 		scancel()
 		if err == nil {
 			atomic.AddInt64(&s.atomicModifiedKeys, modifiedKeys)
+			atomic.AddInt64(&s.atomicRequests, 1)
 			continue
 		}
 
@@ -221,6 +224,10 @@ func (s *keyStresser) ModifiedKeys() int64 {
 	return atomic.LoadInt64(&s.atomicModifiedKeys)
 }
 
+func (s *keyStresser) Requests() int64 {
+	return atomic.LoadInt64(&s.atomicRequests)
+}
+
 type stressFunc func(ctx context.Context) (err error, modifiedKeys int64)
 
 type stressEntry struct {
@@ -49,8 +49,10 @@ type leaseStresser struct {
 	rateLimiter *rate.Limiter
 	// atomicModifiedKey records the number of keys created and deleted during a test case
 	atomicModifiedKey int64
-	numLeases         int
-	keysPerLease      int
+	// atomicRequests records the number of successful lease grant and key txn requests
+	atomicRequests int64
+	numLeases      int
+	keysPerLease   int
 
 	aliveLeases      *atomicLeases
 	revokedLeases    *atomicLeases
@@ -308,6 +310,7 @@ func (ls *leaseStresser) createLease(ttl int64) (int64, error) {
 	if err != nil {
 		return -1, err
 	}
+	atomic.AddInt64(&ls.atomicRequests, 1)
 	return int64(resp.ID), nil
 }
 
@@ -431,6 +434,7 @@ func (ls *leaseStresser) attachKeysWithLease(leaseID int64) error {
 		if err == nil {
 			// since all created keys will be deleted too, the number of operations on keys will be roughly 2x the number of created keys
 			atomic.AddInt64(&ls.atomicModifiedKey, 2*int64(ls.keysPerLease))
+			atomic.AddInt64(&ls.atomicRequests, 1)
 			return nil
 		}
 		if rpctypes.Error(err) == rpctypes.ErrLeaseNotFound {
@@ -485,3 +489,7 @@ func (ls *leaseStresser) Close() map[string]int {
 func (ls *leaseStresser) ModifiedKeys() int64 {
 	return atomic.LoadInt64(&ls.atomicModifiedKey)
 }
+
+func (ls *leaseStresser) Requests() int64 {
+	return atomic.LoadInt64(&ls.atomicRequests)
+}
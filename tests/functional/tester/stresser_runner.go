@@ -119,3 +119,8 @@ func (rs *runnerStresser) Close() map[string]int {
 func (rs *runnerStresser) ModifiedKeys() int64 {
 	return 1
 }
+
+// Requests returns 0 since runner requests are sent by a separate process.
+func (rs *runnerStresser) Requests() int64 {
+	return 0
+}